@@ -0,0 +1,49 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import "testing"
+
+// allocationRuns is the number of times AllocsUnder calls its function to
+// find the average number of allocations.
+const allocationRuns = 100
+
+// AllocsUnder fails the test if fn allocates more than maxAllocs times per
+// call, on average, as measured by testing.AllocsPerRun.
+func (t *Test) AllocsUnder(maxAllocs uint64, fn func()) {
+	t.Helper()
+	allocs := testing.AllocsPerRun(allocationRuns, fn)
+	t.Attest(
+		allocs <= float64(maxAllocs),
+		"Function made %v allocations per run, more than the allowed %d",
+		allocs, maxAllocs)
+}
+
+// Benchmark runs fn as a benchmark with testing.Benchmark, logs the result
+// under name, and returns it. It fails the test if the benchmark failed.
+func (t *Test) Benchmark(name string, fn func(b *testing.B)) testing.BenchmarkResult {
+	t.Helper()
+	result := testing.Benchmark(fn)
+	t.Attest(result.N > 0, "Benchmark %s failed", name)
+	t.Logf("%s: %s", name, result.String())
+	return result
+}
+
+// BenchmarkUnder is like Benchmark, but also fails the test if an operation
+// took longer than maxNsPerOp nanoseconds on average.
+func (t *Test) BenchmarkUnder(name string, maxNsPerOp float64, fn func(b *testing.B)) {
+	t.Helper()
+	result := t.Benchmark(name, fn)
+	if result.N == 0 {
+		return
+	}
+	nsPerOp := float64(result.T.Nanoseconds()) / float64(result.N)
+	t.Attest(
+		nsPerOp <= maxNsPerOp,
+		"Benchmark %s took %v ns/op, more than the allowed %v",
+		name, nsPerOp, maxNsPerOp)
+}
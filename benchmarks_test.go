@@ -0,0 +1,44 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import "testing"
+
+// allocationSink keeps allocations in tests from being optimized away.
+var allocationSink []byte
+
+func TestAllocsUnder(t *testing.T) {
+	test := New(t)
+	total := 0
+	test.AllocsUnder(0, func() { total++ })
+	test.AllocsUnder(1, func() { allocationSink = make([]byte, 64) })
+	test.Attest(
+		fails(func(test *Test) {
+			test.AllocsUnder(0, func() { allocationSink = make([]byte, 64) })
+		}),
+		"AllocsUnder passed for a function which allocates")
+}
+
+func TestBenchmark(t *testing.T) {
+	test := New(t)
+	result := test.Benchmark("increment", func(b *testing.B) {
+		total := 0
+		for i := 0; i < b.N; i++ {
+			total++
+		}
+	})
+	test.GreaterThan(0, result.N)
+	test.Attest(
+		fails(func(test *Test) {
+			test.BenchmarkUnder("allocate", 0, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					allocationSink = make([]byte, 64)
+				}
+			})
+		}),
+		"BenchmarkUnder passed for a benchmark over its limit")
+}
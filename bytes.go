@@ -0,0 +1,48 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"bytes"
+	"encoding/hex"
+)
+
+// firstDifference returns the offset of the first byte at which a and b
+// differ, or the length of the shorter if one is a prefix of the other.
+func firstDifference(a, b []byte) int {
+	offset := 0
+	for offset < len(a) && offset < len(b) && a[offset] == b[offset] {
+		offset++
+	}
+	return offset
+}
+
+// BytesEqual fails the test unless expected and actual hold the same bytes, as
+// determined by bytes.Equal, so nil equals an empty slice. On failure the
+// offset of the first differing byte is reported, along with a line-by-line
+// diff of the hex dumps of both slices.
+func (t *Test) BytesEqual(expected, actual []byte, msgAndFmt ...interface{}) {
+	t.Helper()
+	if bytes.Equal(expected, actual) {
+		t.assertions++
+		return
+	}
+	offset := firstDifference(expected, actual)
+	diff := lineDiff(hex.Dump(expected), hex.Dump(actual))
+	if len(msgAndFmt) == 0 {
+		t.Attest(
+			false,
+			"Bytes differ from offset %d (0x%x): expected %d bytes, got %d. "+
+				"Diff of hex dumps (-expected +actual):\n%s",
+			offset, offset, len(expected), len(actual), diff)
+		return
+	}
+	t.Logf(
+		"Bytes differ from offset %d (0x%x). Diff of hex dumps (-expected +actual):\n%s",
+		offset, offset, diff)
+	t.Attest(false, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
@@ -0,0 +1,36 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBytesEqual(t *testing.T) {
+	test := New(t)
+	test.BytesEqual([]byte{0x00, 0xff, 0x10}, []byte{0x00, 0xff, 0x10})
+	test.BytesEqual(nil, []byte{})
+	test.Attest(
+		fails(func(test *Test) { test.BytesEqual([]byte("abcd"), []byte("abcd!")) }),
+		"BytesEqual passed for slices of different lengths")
+	output := failureOutput(t, func(test *Test) {
+		expected := []byte(strings.Repeat("a", 40))
+		actual := append([]byte(strings.Repeat("a", 20)), []byte(strings.Repeat("b", 20))...)
+		test.BytesEqual(expected, actual)
+	})
+	for _, want := range []string{
+		"Bytes differ from offset 20 (0x14)",
+		"-00000010  61 61 61 61",
+		"+00000010  61 61 61 61 62 62",
+		" 00000000  61 61 61 61",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in the output, got:\n%s", want, output)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"bytes"
+	"io"
+	"os"
+)
+
+// CaptureWrites calls fn with a buffer, and returns everything fn wrote to it.
+// This is for testing code which writes to an io.Writer.
+func (t *Test) CaptureWrites(fn func(w io.Writer)) string {
+	var buffer bytes.Buffer
+	fn(&buffer)
+	return buffer.String()
+}
+
+// WritesEqual fails the test unless fn writes exactly expected to the
+// io.Writer it's given.
+func (t *Test) WritesEqual(expected string, fn func(w io.Writer), msgAndFmt ...interface{}) {
+	t.Helper()
+	actual := t.CaptureWrites(fn)
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"Expected %q to be written, got %q", expected, actual}
+	}
+	t.Attest(actual == expected, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// CaptureStdout calls fn with os.Stdout redirected to a pipe, and returns
+// everything fn printed to it. os.Stdout is restored before returning, even
+// if fn panics.
+func (t *Test) CaptureStdout(fn func()) string {
+	t.Helper()
+	return t.captureFile(&os.Stdout, fn)
+}
+
+// CaptureStderr is like CaptureStdout, for os.Stderr.
+func (t *Test) CaptureStderr(fn func()) string {
+	t.Helper()
+	return t.captureFile(&os.Stderr, fn)
+}
+
+// captureFile replaces *file with the writing end of a pipe while fn is
+// called. The pipe is drained concurrently, so that fn doesn't block once the
+// pipe's buffer is full.
+func (t *Test) captureFile(file **os.File, fn func()) string {
+	t.Helper()
+	reader, writer, err := os.Pipe()
+	t.StopIf(err, "Couldn't create a pipe to capture output: %v", err)
+	var captured bytes.Buffer
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		io.Copy(&captured, reader)
+		reader.Close()
+	}()
+	original := *file
+	*file = writer
+	func() {
+		defer func() {
+			*file = original
+			writer.Close()
+		}()
+		fn()
+	}()
+	<-done
+	return captured.String()
+}
@@ -0,0 +1,55 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func greet(w io.Writer, name string) {
+	fmt.Fprintf(w, "Hello, %s!\n", name)
+}
+
+func TestCaptureWrites(t *testing.T) {
+	test := New(t)
+	test.Equals("Hello, world!\n", test.CaptureWrites(func(w io.Writer) { greet(w, "world") }))
+	test.Equals("", test.CaptureWrites(func(io.Writer) {}))
+}
+
+func TestWritesEqual(t *testing.T) {
+	test := New(t)
+	test.WritesEqual("Hello, world!\n", func(w io.Writer) { greet(w, "world") })
+	test.Attest(
+		fails(func(test *Test) {
+			test.WritesEqual("Hello, world!\n", func(w io.Writer) { greet(w, "there") })
+		}),
+		"WritesEqual passed for different output")
+}
+
+func TestCaptureStdout(t *testing.T) {
+	test := New(t)
+	original := os.Stdout
+	test.Equals("Hello, world!\n", test.CaptureStdout(func() { fmt.Println("Hello, world!") }))
+	test.Same(original, os.Stdout)
+	large := strings.Repeat("x", 1<<20)
+	test.Equals(large, test.CaptureStdout(func() { fmt.Print(large) }))
+	test.AttestPanics(func(...interface{}) {
+		test.CaptureStdout(func() { panic("in fn") })
+	})
+	test.Same(original, os.Stdout)
+}
+
+func TestCaptureStderr(t *testing.T) {
+	test := New(t)
+	original := os.Stderr
+	test.Equals("error!\n", test.CaptureStderr(func() { fmt.Fprintln(os.Stderr, "error!") }))
+	test.Same(original, os.Stderr)
+}
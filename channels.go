@@ -0,0 +1,82 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"reflect"
+	"time"
+)
+
+// receivable returns ch as a reflect.Value, or fails the test and returns
+// false if ch isn't a channel which can be received from.
+func (t *Test) receivable(ch interface{}) (reflect.Value, bool) {
+	t.Helper()
+	value := reflect.ValueOf(ch)
+	if value.Kind() != reflect.Chan || value.Type().ChanDir()&reflect.RecvDir == 0 {
+		t.errorf("Expected a channel which can be received from, got %#v (%T)", ch, ch)
+		return value, false
+	}
+	return value, true
+}
+
+// Receives waits up to timeout to receive one value from the channel ch, and
+// fails the test unless it arrives and is deeply equal to expected. The test
+// also fails if ch is closed, or isn't a channel.
+func (t *Test) Receives(
+	ch, expected interface{},
+	timeout time.Duration,
+	msgAndFmt ...interface{},
+) {
+	t.Helper()
+	channel, ok := t.receivable(ch)
+	if !ok {
+		return
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	chosen, received, ok := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: channel},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C)},
+	})
+	if chosen == 1 {
+		t.errorf("Timed out after %v waiting to receive %#v", timeout, expected)
+		return
+	}
+	if !ok {
+		t.errorf("Channel was closed while waiting to receive %#v", expected)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Received %#v, expected %#v",
+			received.Interface(), expected,
+		}
+	}
+	t.Attest(
+		reflect.DeepEqual(received.Interface(), expected),
+		msgAndFmt[0].(string),
+		msgAndFmt[1:]...)
+}
+
+// Closed fails the test unless the channel ch is closed. It doesn't block, so
+// an open channel with nothing to receive fails immediately. Note that if ch
+// is open and has a value ready, that value is received and discarded.
+func (t *Test) Closed(ch interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	channel, ok := t.receivable(ch)
+	if !ok {
+		return
+	}
+	chosen, _, received := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: channel},
+		{Dir: reflect.SelectDefault},
+	})
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"Channel %v (%T) was expected to be closed", ch, ch}
+	}
+	t.Attest(chosen == 0 && !received, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
@@ -0,0 +1,41 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReceives(t *testing.T) {
+	test := New(t)
+	buffered := make(chan string, 1)
+	buffered <- "delivered"
+	test.Receives(buffered, "delivered", time.Second)
+	go func() { buffered <- "later" }()
+	test.Receives(buffered, "later", time.Second)
+	test.Attest(
+		fails(func(test *Test) { test.Receives(buffered, "never sent", 10*time.Millisecond) }),
+		"Receives passed after timing out")
+	buffered <- "wrong value"
+	test.Attest(
+		fails(func(test *Test) { test.Receives(buffered, "delivered", time.Second) }),
+		"Receives passed for a different value")
+	test.Attest(
+		fails(func(test *Test) { test.Receives("not a channel", "", time.Second) }),
+		"Receives passed for a value which isn't a channel")
+}
+
+func TestClosed(t *testing.T) {
+	test := New(t)
+	channel := make(chan int)
+	test.Attest(
+		fails(func(test *Test) { test.Closed(channel) }),
+		"Closed passed for an open channel")
+	close(channel)
+	test.Closed(channel)
+}
@@ -0,0 +1,401 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+/*
+These tests check the contents of collections -- slices, arrays, maps and
+strings. They accept the collection as an interface{} and inspect it through
+reflection, failing the test rather than panicking if they're given a value
+which isn't a collection.
+*/
+
+// contains reports whether element is an element of a slice or array, a key
+// of a map, or a substring of a string. ok is false if container is none of
+// those.
+func contains(container, element interface{}) (found, ok bool) {
+	value := reflect.ValueOf(container)
+	switch value.Kind() {
+	case reflect.String:
+		substring, isString := element.(string)
+		return isString && strings.Contains(value.String(), substring), true
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if reflect.DeepEqual(value.Index(i).Interface(), element) {
+				return true, true
+			}
+		}
+		return false, true
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			if reflect.DeepEqual(key.Interface(), element) {
+				return true, true
+			}
+		}
+		return false, true
+	}
+	return false, false
+}
+
+// Contains fails the test unless element is an element of container, if it's
+// a slice or an array; a key of container, if it's a map; or a substring of
+// container, if it's a string.
+func (t *Test) Contains(container, element interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	found, ok := contains(container, element)
+	if !ok {
+		t.errorf(
+			"Can't check whether %#v contains %#v: %T isn't a slice, array, map or string.",
+			container,
+			element,
+			container)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"%#v doesn't contain %#v", container, element}
+	}
+	t.Attest(found, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// DoesNotContain is the inverse of Contains.
+func (t *Test) DoesNotContain(container, element interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	found, ok := contains(container, element)
+	if !ok {
+		t.errorf(
+			"Can't check whether %#v contains %#v: %T isn't a slice, array, map or string.",
+			container,
+			element,
+			container)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"%#v was expected to not contain %#v, but did",
+			container,
+			element,
+		}
+	}
+	t.AttestNot(found, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// Len fails the test unless container has the expected length. container may
+// be a slice, array, map, string or channel; any other value fails the test.
+func (t *Test) Len(container interface{}, expected int, msgAndFmt ...interface{}) {
+	t.Helper()
+	value := reflect.ValueOf(container)
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.String, reflect.Chan:
+	default:
+		t.errorf("Can't check the length of %#v: %T has no length.", container, container)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"expected length %d, got %d (%#v)",
+			expected,
+			value.Len(),
+			container,
+		}
+	}
+	t.Attest(value.Len() == expected, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// isEmpty reports whether value is nil, has a length of zero, or is the zero
+// value of its type.
+func isEmpty(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Chan, reflect.String, reflect.Array:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	}
+	return v.IsZero()
+}
+
+// Empty fails the test unless value is empty, that is: nil; a slice, map,
+// string or channel of length zero; or the zero value of its type.
+func (t *Test) Empty(value interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"%#v was expected to be empty", value}
+	}
+	t.Attest(isEmpty(value), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// NotEmpty is the inverse of Empty.
+func (t *Test) NotEmpty(value interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"%#v was expected to not be empty", value}
+	}
+	t.AttestNot(isEmpty(value), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// checkOrder fails the test unless each element of the slice or array is in
+// order relative to the one before it; that is, unless compare of the pair
+// returns a result which inOrder accepts. direction describes the expected
+// order for the failure message.
+func (t *Test) checkOrder(
+	slice interface{},
+	inOrder func(comparison int) bool,
+	direction string,
+	msgAndFmt []interface{},
+) {
+	t.Helper()
+	value := reflect.ValueOf(slice)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		t.errorf("Can't check the order of %#v: %T isn't a slice or array.", slice, slice)
+		return
+	}
+	// the index of the first element which is out of order, or zero
+	outOfOrder := 0
+	for i := 1; i < value.Len() && outOfOrder == 0; i++ {
+		previous, current := value.Index(i-1).Interface(), value.Index(i).Interface()
+		comparison, ok := compare(previous, current)
+		if !ok {
+			t.errorf(
+				"Can't check the order of %#v: elements of type %T can't be ordered.",
+				slice, previous)
+			return
+		}
+		if !inOrder(comparison) {
+			outOfOrder = i
+		}
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"%#v isn't sorted in %s order: element %d is out of order with element %d",
+			slice, direction, outOfOrder - 1, outOfOrder,
+		}
+	}
+	t.Attest(outOfOrder == 0, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// Sorted fails the test unless the elements of slice, which may also be an
+// array, are in non-decreasing order. The elements must be numbers or strings.
+func (t *Test) Sorted(slice interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	t.checkOrder(slice, func(comparison int) bool { return comparison <= 0 }, "ascending", msgAndFmt)
+}
+
+// SortedDescending is like Sorted, but for non-increasing order.
+func (t *Test) SortedDescending(slice interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	t.checkOrder(slice, func(comparison int) bool { return comparison >= 0 }, "descending", msgAndFmt)
+}
+
+// ElementsMatch fails the test unless the slices (or arrays) expected and
+// actual contain the same elements, in any order. Duplicates count: [1, 1, 2]
+// doesn't match [1, 2, 2]. Elements are compared with reflect.DeepEqual.
+func (t *Test) ElementsMatch(expected, actual interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	expectedValue, actualValue := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	for _, value := range []reflect.Value{expectedValue, actualValue} {
+		if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+			t.errorf(
+				"Can't match the elements of %#v and %#v: found types %T and %T, "+
+					"expected slices or arrays.",
+				expected, actual, expected, actual)
+			return
+		}
+	}
+	matched := make([]bool, expectedValue.Len())
+	var missing, extra []interface{}
+	for j := 0; j < actualValue.Len(); j++ {
+		element := actualValue.Index(j).Interface()
+		found := false
+		for i := range matched {
+			if !matched[i] && reflect.DeepEqual(expectedValue.Index(i).Interface(), element) {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			extra = append(extra, element)
+		}
+	}
+	for i, wasMatched := range matched {
+		if !wasMatched {
+			missing = append(missing, expectedValue.Index(i).Interface())
+		}
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Elements of %#v didn't match %#v. Missing: %#v. Extra: %#v.",
+			actual, expected, missing, extra,
+		}
+	}
+	t.Attest(
+		len(missing) == 0 && len(extra) == 0,
+		msgAndFmt[0].(string),
+		msgAndFmt[1:]...)
+}
+
+// lookup finds the value which key maps to in the map m, comparing keys with
+// reflect.DeepEqual, so that keys of an interface type needn't match exactly.
+func lookup(m reflect.Value, key interface{}) (value interface{}, found bool) {
+	for _, k := range m.MapKeys() {
+		if reflect.DeepEqual(k.Interface(), key) {
+			return m.MapIndex(k).Interface(), true
+		}
+	}
+	return nil, false
+}
+
+// MapContainsKey fails the test unless key is a key of the map m.
+func (t *Test) MapContainsKey(m, key interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	value := reflect.ValueOf(m)
+	if value.Kind() != reflect.Map {
+		t.errorf("Can't check whether %#v has the key %#v: %T isn't a map.", m, key, m)
+		return
+	}
+	_, found := lookup(value, key)
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"%#v doesn't have the key %#v", m, key}
+	}
+	t.Attest(found, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// MapContainsValue fails the test unless some key of the map m maps to value.
+func (t *Test) MapContainsValue(m, value interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	mapValue := reflect.ValueOf(m)
+	if mapValue.Kind() != reflect.Map {
+		t.errorf("Can't check whether %#v has the value %#v: %T isn't a map.", m, value, m)
+		return
+	}
+	found := false
+	for _, key := range mapValue.MapKeys() {
+		if reflect.DeepEqual(mapValue.MapIndex(key).Interface(), value) {
+			found = true
+			break
+		}
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"%#v doesn't have the value %#v", m, value}
+	}
+	t.Attest(found, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// MapEntryEquals fails the test unless key is a key of the map m, and maps to
+// a value deeply equal to expectedValue.
+func (t *Test) MapEntryEquals(m, key, expectedValue interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	mapValue := reflect.ValueOf(m)
+	if mapValue.Kind() != reflect.Map {
+		t.errorf("Can't check the entry %#v of %#v: %T isn't a map.", key, m, m)
+		return
+	}
+	actual, found := lookup(mapValue, key)
+	if !found {
+		t.errorf("%#v doesn't have the key %#v", m, key)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Key %#v of %#v was expected to be %#v, got %#v",
+			key, m, expectedValue, actual,
+		}
+	}
+	t.Attest(
+		reflect.DeepEqual(actual, expectedValue),
+		msgAndFmt[0].(string),
+		msgAndFmt[1:]...)
+}
+
+// Subset fails the test unless subset is contained by superset. If both are
+// slices or arrays, every element of subset must be an element of superset; if
+// both are maps, every key of subset must be present in superset and map to
+// an equal value. The first element or entry which is missing is reported.
+func (t *Test) Subset(superset, subset interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	superValue, subValue := reflect.ValueOf(superset), reflect.ValueOf(subset)
+	var missing string
+	switch {
+	case (superValue.Kind() == reflect.Slice || superValue.Kind() == reflect.Array) &&
+		(subValue.Kind() == reflect.Slice || subValue.Kind() == reflect.Array):
+		for i := 0; i < subValue.Len(); i++ {
+			element := subValue.Index(i).Interface()
+			if found, _ := contains(superset, element); !found {
+				missing = fmt.Sprintf("element %#v", element)
+				break
+			}
+		}
+	case superValue.Kind() == reflect.Map && subValue.Kind() == reflect.Map:
+		for _, key := range subValue.MapKeys() {
+			expected := subValue.MapIndex(key).Interface()
+			actual, found := lookup(superValue, key.Interface())
+			if !found || !reflect.DeepEqual(actual, expected) {
+				missing = fmt.Sprintf("entry %#v: %#v", key.Interface(), expected)
+				break
+			}
+		}
+	default:
+		t.errorf(
+			"Can't check whether %#v is a subset of %#v: found types %T and %T, "+
+				"expected two slices or arrays, or two maps.",
+			subset, superset, subset, superset)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"%#v isn't a subset of %#v: %s is missing",
+			subset, superset, missing,
+		}
+	}
+	t.Attest(missing == "", msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// Count fails the test unless element occurs exactly expected times in
+// container. container may be a slice or array, whose elements are compared to
+// element with reflect.DeepEqual, or a string, in which case element must be a
+// string and non-overlapping occurrences of it are counted.
+func (t *Test) Count(container, element interface{}, expected int, msgAndFmt ...interface{}) {
+	t.Helper()
+	value := reflect.ValueOf(container)
+	var count int
+	switch value.Kind() {
+	case reflect.String:
+		substring, isString := element.(string)
+		if !isString {
+			t.errorf(
+				"Can't count %#v in the string %q: %T isn't a string.",
+				element, container, element)
+			return
+		}
+		count = strings.Count(value.String(), substring)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if reflect.DeepEqual(value.Index(i).Interface(), element) {
+				count++
+			}
+		}
+	default:
+		t.errorf(
+			"Can't count %#v in %#v: %T isn't a slice, array or string.",
+			element, container, container)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"expected %#v to occur %d time(s) in %#v, but it occurred %d time(s)",
+			element, expected, container, count,
+		}
+	}
+	t.Attest(count == expected, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
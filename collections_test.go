@@ -0,0 +1,191 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"math"
+	"testing"
+)
+
+func TestContains(t *testing.T) {
+	test := New(t)
+	test.Contains([]string{"a", "b", "c"}, "b")
+	test.Contains([3]int{1, 2, 3}, 3)
+	test.Contains(map[string]int{"key": 1}, "key")
+	test.Contains("seafood", "foo")
+	test.Attest(
+		fails(func(test *Test) { test.Contains([]int{1, 2, 3}, 4) }),
+		"Contains passed for a missing element")
+	test.Attest(
+		fails(func(test *Test) { test.Contains(5, 5) }),
+		"Contains passed for a value which isn't a container")
+}
+
+func TestDoesNotContain(t *testing.T) {
+	test := New(t)
+	test.DoesNotContain([]string{"a", "b", "c"}, "d")
+	test.DoesNotContain([3]int{1, 2, 3}, 4)
+	test.DoesNotContain(map[string]int{"key": 1}, "other key")
+	test.DoesNotContain("seafood", "bar")
+	test.Attest(
+		fails(func(test *Test) { test.DoesNotContain("seafood", "foo") }),
+		"DoesNotContain passed for a present substring")
+}
+
+func TestLen(t *testing.T) {
+	test := New(t)
+	test.Len([]int{1, 2, 3}, 3)
+	test.Len([2]string{}, 2)
+	test.Len(map[int]int{1: 1}, 1)
+	test.Len("four", 4)
+	channel := make(chan int, 2)
+	channel <- 1
+	test.Len(channel, 1)
+	test.Attest(
+		fails(func(test *Test) { test.Len([]int{1, 2, 3, 4, 5}, 3) }),
+		"Len passed for the wrong length")
+	test.Attest(
+		fails(func(test *Test) { test.Len(5, 1) }),
+		"Len passed for a value without a length")
+}
+
+func TestEmpty(t *testing.T) {
+	test := New(t)
+	var nilPointer *int
+	var nilSlice []int
+	test.Empty(nil)
+	test.Empty(nilPointer)
+	test.Empty(nilSlice)
+	test.Empty([]string{})
+	test.Empty(map[string]int{})
+	test.Empty("")
+	test.Empty(0)
+	test.Attest(
+		fails(func(test *Test) { test.Empty([]int{1}) }),
+		"Empty passed for a non-empty slice")
+}
+
+func TestNotEmpty(t *testing.T) {
+	test := New(t)
+	value := 5
+	test.NotEmpty(&value)
+	test.NotEmpty([]int{1})
+	test.NotEmpty(map[string]int{"key": 1})
+	test.NotEmpty("non-empty")
+	test.NotEmpty(value)
+	test.Attest(
+		fails(func(test *Test) { test.NotEmpty("") }),
+		"NotEmpty passed for an empty string")
+}
+
+func TestSorted(t *testing.T) {
+	test := New(t)
+	test.Sorted([]int{1, 2, 2, 3})
+	test.Sorted([]string{"apple", "banana"})
+	test.Sorted([]float64{})
+	test.Sorted([1]uint{5})
+	test.SortedDescending([]int{3, 2, 2, 1})
+	test.Attest(
+		fails(func(test *Test) { test.Sorted([]int{1, 3, 2}) }),
+		"Sorted passed for an unsorted slice")
+	test.Attest(
+		fails(func(test *Test) { test.SortedDescending([]int{1, 2}) }),
+		"SortedDescending passed for an ascending slice")
+	test.Attest(
+		fails(func(test *Test) { test.Sorted([]interface{}{1, "two"}) }),
+		"Sorted passed for elements which can't be ordered")
+	test.Attest(
+		fails(func(test *Test) { test.Sorted([]float64{math.NaN(), 1, 2}) }),
+		"Sorted passed for a slice containing NaN")
+}
+
+func TestElementsMatch(t *testing.T) {
+	test := New(t)
+	test.ElementsMatch([]int{1, 2, 3}, []int{3, 1, 2})
+	test.ElementsMatch([]string{"a", "a", "b"}, [3]string{"a", "b", "a"})
+	test.ElementsMatch([][]int{{1}, {2}}, [][]int{{2}, {1}})
+	test.Attest(
+		fails(func(test *Test) { test.ElementsMatch([]int{1, 1, 2}, []int{1, 2, 2}) }),
+		"ElementsMatch passed for mismatched duplicates")
+	test.Attest(
+		fails(func(test *Test) { test.ElementsMatch([]int{1, 2}, []int{1, 2, 3}) }),
+		"ElementsMatch passed for differing lengths")
+	test.Attest(
+		fails(func(test *Test) { test.ElementsMatch([]int{1}, 1) }),
+		"ElementsMatch passed for a value which isn't a slice")
+}
+
+func TestMapContainsKey(t *testing.T) {
+	test := New(t)
+	test.MapContainsKey(map[string]int{"one": 1}, "one")
+	test.MapContainsKey(map[interface{}]bool{2: true}, 2)
+	test.Attest(
+		fails(func(test *Test) { test.MapContainsKey(map[string]int{"one": 1}, "two") }),
+		"MapContainsKey passed for a missing key")
+	test.Attest(
+		fails(func(test *Test) { test.MapContainsKey([]string{"one"}, "one") }),
+		"MapContainsKey passed for a value which isn't a map")
+}
+
+func TestMapContainsValue(t *testing.T) {
+	test := New(t)
+	test.MapContainsValue(map[string]int{"one": 1, "two": 2}, 2)
+	test.MapContainsValue(map[int][]string{1: {"a"}}, []string{"a"})
+	test.Attest(
+		fails(func(test *Test) { test.MapContainsValue(map[string]int{"one": 1}, 2) }),
+		"MapContainsValue passed for a missing value")
+}
+
+func TestMapEntryEquals(t *testing.T) {
+	test := New(t)
+	test.MapEntryEquals(map[string]int{"one": 1, "two": 2}, "two", 2)
+	test.Attest(
+		fails(func(test *Test) { test.MapEntryEquals(map[string]int{"one": 1}, "one", 2) }),
+		"MapEntryEquals passed for the wrong value")
+	test.Attest(
+		fails(func(test *Test) { test.MapEntryEquals(map[string]int{"one": 1}, "two", 2) }),
+		"MapEntryEquals passed for a missing key")
+	test.Attest(
+		fails(func(test *Test) { test.MapEntryEquals("one", "one", 1) }),
+		"MapEntryEquals passed for a value which isn't a map")
+}
+
+func TestSubset(t *testing.T) {
+	test := New(t)
+	test.Subset([]int{1, 2, 3, 4}, []int{4, 2})
+	test.Subset([]int{1, 2, 3}, [0]int{})
+	test.Subset(
+		map[string]interface{}{"id": 1, "name": "attest", "tags": []string{"go"}},
+		map[string]interface{}{"name": "attest", "tags": []string{"go"}})
+	test.Attest(
+		fails(func(test *Test) { test.Subset([]int{1, 2, 3}, []int{2, 5}) }),
+		"Subset passed for a missing element")
+	test.Attest(
+		fails(func(test *Test) {
+			test.Subset(map[string]int{"a": 1, "b": 2}, map[string]int{"b": 3})
+		}),
+		"Subset passed for an entry with a different value")
+	test.Attest(
+		fails(func(test *Test) { test.Subset(map[string]int{"a": 1}, []string{"a"}) }),
+		"Subset passed for a map and a slice")
+}
+
+func TestCount(t *testing.T) {
+	test := New(t)
+	test.Count([]int{1, 2, 1, 3, 1}, 1, 3)
+	test.Count([2]string{"a", "b"}, "c", 0)
+	test.Count("banana", "an", 2)
+	test.Attest(
+		fails(func(test *Test) { test.Count([]string{"a", "a"}, "a", 1) }),
+		"Count passed for the wrong number of duplicates")
+	test.Attest(
+		fails(func(test *Test) { test.Count("banana", "a", 2) }),
+		"Count passed for the wrong number of substrings")
+	test.Attest(
+		fails(func(test *Test) { test.Count(map[string]int{"a": 1}, "a", 1) }),
+		"Count passed for a map")
+}
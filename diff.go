@@ -0,0 +1,260 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// maxPrettyDepth limits how deeply pretty descends into a value, so that
+// cyclic data structures can still be rendered.
+const maxPrettyDepth = 16
+
+// pretty renders value over multiple lines, with one struct field, slice
+// element or map entry per line, so that two renderings can be compared line
+// by line.
+func pretty(value interface{}) string {
+	var builder strings.Builder
+	writePretty(&builder, reflect.ValueOf(value), 0)
+	return builder.String()
+}
+
+func writePretty(builder *strings.Builder, value reflect.Value, depth int) {
+	if depth > maxPrettyDepth {
+		builder.WriteString("...")
+		return
+	}
+	indent := strings.Repeat("\t", depth+1)
+	closing := strings.Repeat("\t", depth) + "}"
+	switch value.Kind() {
+	case reflect.Invalid:
+		builder.WriteString("nil")
+	case reflect.Struct:
+		fmt.Fprintf(builder, "%s{\n", value.Type())
+		for i := 0; i < value.NumField(); i++ {
+			builder.WriteString(indent + value.Type().Field(i).Name + ": ")
+			writePretty(builder, value.Field(i), depth+1)
+			builder.WriteString(",\n")
+		}
+		builder.WriteString(closing)
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			fmt.Fprintf(builder, "%s(nil)", value.Type())
+			return
+		}
+		fmt.Fprintf(builder, "%s{\n", value.Type())
+		for i := 0; i < value.Len(); i++ {
+			builder.WriteString(indent)
+			writePretty(builder, value.Index(i), depth+1)
+			builder.WriteString(",\n")
+		}
+		builder.WriteString(closing)
+	case reflect.Map:
+		if value.IsNil() {
+			fmt.Fprintf(builder, "%s(nil)", value.Type())
+			return
+		}
+		keys := value.MapKeys()
+		// map iteration order is random; sort so that equal maps render equally
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprintf("%#v", keys[i]) < fmt.Sprintf("%#v", keys[j])
+		})
+		fmt.Fprintf(builder, "%s{\n", value.Type())
+		for _, key := range keys {
+			fmt.Fprintf(builder, "%s%#v: ", indent, key)
+			writePretty(builder, value.MapIndex(key), depth+1)
+			builder.WriteString(",\n")
+		}
+		builder.WriteString(closing)
+	case reflect.Ptr:
+		if value.IsNil() {
+			fmt.Fprintf(builder, "(%s)(nil)", value.Type())
+			return
+		}
+		builder.WriteString("&")
+		writePretty(builder, value.Elem(), depth)
+	case reflect.Interface:
+		writePretty(builder, value.Elem(), depth)
+	default:
+		// fmt formats the value held by a reflect.Value, even when it came
+		// from an unexported field.
+		fmt.Fprintf(builder, "%#v", value)
+	}
+}
+
+// lineDiff compares expected and actual line by line, returning every line
+// prefixed with "-" if it only appears in expected, "+" if it only appears in
+// actual, or " " if it appears in both.
+func lineDiff(expected, actual string) string {
+	a := strings.Split(expected, "\n")
+	b := strings.Split(actual, "\n")
+	// common[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:].
+	common := make([][]int, len(a)+1)
+	for i := range common {
+		common[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else if common[i+1][j] >= common[i][j+1] {
+				common[i][j] = common[i+1][j]
+			} else {
+				common[i][j] = common[i][j+1]
+			}
+		}
+	}
+	var diff strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			diff.WriteString(" " + a[i] + "\n")
+			i++
+			j++
+		case j == len(b) || (i < len(a) && common[i+1][j] >= common[i][j+1]):
+			diff.WriteString("-" + a[i] + "\n")
+			i++
+		default:
+			diff.WriteString("+" + b[j] + "\n")
+			j++
+		}
+	}
+	return diff.String()
+}
+
+// DeepEquals checks that expected and actual are deeply equal, as determined by
+// reflect.DeepEqual. Where Equals prints both values on failure, DeepEquals
+// prints a line-by-line diff of them, so the field which differs can be found
+// quickly in large structures.
+func (t *Test) DeepEquals(expected, actual interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	if reflect.DeepEqual(expected, actual) {
+		t.assertions++
+		return
+	}
+	diff := lineDiff(pretty(expected), pretty(actual))
+	if len(msgAndFmt) == 0 {
+		t.Attest(false, "Values weren't deeply equal. Diff (-expected +actual):\n%s", diff)
+		return
+	}
+	t.Logf("Diff (-expected +actual):\n%s", diff)
+	t.Attest(false, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// Diff lists the differences between a and b, one per line, each naming the
+// path to a differing struct field, slice element or map entry along with its
+// value in a and in b. Only exported struct fields are walked; if structs
+// differ only in their unexported fields, the struct itself is listed. An
+// empty string is returned if a and b are deeply equal.
+func (t *Test) Diff(a, b interface{}) string {
+	var differences []string
+	walkDiff(&differences, "", reflect.ValueOf(a), reflect.ValueOf(b), 0)
+	return strings.Join(differences, "\n")
+}
+
+// walkDiff appends the differences between a and b, found at path, to
+// differences.
+func walkDiff(differences *[]string, path string, a, b reflect.Value, depth int) {
+	report := func(format string, args ...interface{}) {
+		name := strings.TrimPrefix(path, ".")
+		if name == "" {
+			name = "value"
+		}
+		*differences = append(*differences, name+": "+fmt.Sprintf(format, args...))
+	}
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() != b.IsValid() {
+			report("expected %s, got %s", describe(a), describe(b))
+		}
+		return
+	}
+	if a.Type() != b.Type() {
+		report("expected type %s, got %s", a.Type(), b.Type())
+		return
+	}
+	if depth > maxPrettyDepth {
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			report("differs below the maximum depth")
+		}
+		return
+	}
+	switch a.Kind() {
+	case reflect.Struct:
+		before := len(*differences)
+		for i := 0; i < a.NumField(); i++ {
+			field := a.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			walkDiff(differences, path+"."+field.Name, a.Field(i), b.Field(i), depth+1)
+		}
+		if len(*differences) == before && !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			report("unexported fields differ")
+		}
+	case reflect.Slice, reflect.Array:
+		if a.Kind() == reflect.Slice && a.IsNil() != b.IsNil() {
+			report("expected %s, got %s", describe(a), describe(b))
+			return
+		}
+		if a.Len() != b.Len() {
+			report("expected length %d, got %d", a.Len(), b.Len())
+			return
+		}
+		for i := 0; i < a.Len(); i++ {
+			walkDiff(differences, fmt.Sprintf("%s[%d]", path, i), a.Index(i), b.Index(i), depth+1)
+		}
+	case reflect.Map:
+		if a.IsNil() != b.IsNil() {
+			report("expected %s, got %s", describe(a), describe(b))
+			return
+		}
+		keys := a.MapKeys()
+		for _, key := range b.MapKeys() {
+			if !a.MapIndex(key).IsValid() {
+				keys = append(keys, key)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprintf("%#v", keys[i]) < fmt.Sprintf("%#v", keys[j])
+		})
+		for _, key := range keys {
+			// a missing entry is an invalid reflect.Value, described as
+			// "nothing"
+			walkDiff(
+				differences,
+				fmt.Sprintf("%s[%#v]", path, key),
+				a.MapIndex(key),
+				b.MapIndex(key),
+				depth+1)
+		}
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				report("expected %s, got %s", describe(a), describe(b))
+			}
+			return
+		}
+		walkDiff(differences, path, a.Elem(), b.Elem(), depth+1)
+	default:
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			report("expected %s, got %s", describe(a), describe(b))
+		}
+	}
+}
+
+// describe formats a value found by walkDiff, which may be missing.
+func describe(value reflect.Value) string {
+	if !value.IsValid() {
+		return "nothing"
+	}
+	return fmt.Sprintf("%#v", value.Interface())
+}
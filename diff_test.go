@@ -0,0 +1,108 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"strings"
+	"testing"
+)
+
+type diffServer struct {
+	Host string
+	Port int
+}
+
+type diffConfig struct {
+	Name    string
+	Servers []diffServer
+	Labels  map[string]string
+}
+
+func TestDeepEquals(t *testing.T) {
+	test := New(t)
+	config := func(port int) diffConfig {
+		return diffConfig{
+			Name: "config",
+			Servers: []diffServer{
+				{"primary", 8080},
+				{"secondary", port},
+			},
+			Labels: map[string]string{"env": "test", "team": "core"},
+		}
+	}
+	test.DeepEquals(config(8081), config(8081))
+	output := failureOutput(t, func(test *Test) {
+		test.DeepEquals(config(8081), config(9090))
+	})
+	test.Attest(
+		strings.Contains(output, "-\t\t\tPort: 8081,") &&
+			strings.Contains(output, "+\t\t\tPort: 9090,"),
+		"expected the diff to name the differing field, got:\n%s",
+		output)
+	test.Attest(
+		!strings.Contains(output, "-\t\t\tPort: 8080,"),
+		"expected the diff to only mark the differing field, got:\n%s",
+		output)
+}
+
+func TestDeepEqualsCountsAssertions(t *testing.T) {
+	test := New(t)
+	defer test.ExpectAssertions(2)
+	test.DeepEquals(diffServer{"primary", 8080}, diffServer{"primary", 8080})
+	test.Attest(
+		test.AssertionCount() == 1,
+		"expected a passing DeepEquals to count 1 assertion, counted %d",
+		test.AssertionCount())
+}
+
+func TestLineDiff(t *testing.T) {
+	test := New(t)
+	test.Equals(" a\n-b\n+c\n d\n", lineDiff("a\nb\nd", "a\nc\nd"))
+	test.Equals(" a\n+b\n", lineDiff("a", "a\nb"))
+}
+
+func TestDiff(t *testing.T) {
+	test := New(t)
+	config := func(port int, env string) diffConfig {
+		return diffConfig{
+			Name:    "config",
+			Servers: []diffServer{{"primary", 8080}, {"secondary", port}},
+			Labels:  map[string]string{"env": env},
+		}
+	}
+	test.Equals("", test.Diff(config(8081, "test"), config(8081, "test")))
+	test.Equals(
+		"Servers[1].Port: expected 8081, got 9090",
+		test.Diff(config(8081, "test"), config(9090, "test")))
+	test.Equals(
+		"Labels[\"env\"]: expected \"test\", got \"prod\"",
+		test.Diff(config(8081, "test"), config(8081, "prod")))
+	test.Equals(
+		"value: expected 1, got 2",
+		test.Diff(1, 2))
+	test.Equals(
+		"[\"b\"]: expected nothing, got 2",
+		test.Diff(map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}))
+	test.Equals(
+		"Servers: expected length 2, got 1",
+		test.Diff(config(8081, "test"), diffConfig{
+			Name:    "config",
+			Servers: []diffServer{{"primary", 8080}},
+			Labels:  map[string]string{"env": "test"},
+		}))
+}
+
+func TestEqualsListsDifferences(t *testing.T) {
+	output := failureOutput(t, func(test *Test) {
+		test.Equals(
+			diffConfig{Name: "config", Servers: []diffServer{{"primary", 8080}}},
+			diffConfig{Name: "config", Servers: []diffServer{{"primary", 9090}}})
+	})
+	if !strings.Contains(output, "Servers[0].Port: expected 8080, got 9090") {
+		t.Errorf("expected Equals to name the differing field, got:\n%s", output)
+	}
+}
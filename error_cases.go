@@ -6,7 +6,14 @@
 
 package attest
 
-import "log"
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+)
 
 /*
 These tests are passed (possibly nil) errors. The test fails if the error is
@@ -14,42 +21,91 @@ not nil, and logs the error and, in some cases, an optional custom message.
 */
 
 // AttestPanics -- Attest that when fun is called with args, it causes a panic.
+// The recovered value is returned, so that it can be inspected further.
 // e.g.
 //	t.AttestPanics(func(){log.Printf("Panics, passes test."); panic()})
 //	t.AttestPanics(func(){log.Printf("Doesn't panic, fails test.")})
-func (t *Test) AttestPanics(fun func(...interface{}), args ...interface{}) {
+func (t *Test) AttestPanics(fun func(...interface{}), args ...interface{}) (recovered interface{}) {
+	t.Helper()
 	defer func() {
-		r := recover()
-		t.Attest(r != nil, "Function %v didn't cause a panic!", fun)
+		t.Helper()
+		recovered = recover()
+		t.Attest(recovered != nil, "Function %p didn't cause a panic!", fun)
 	}()
 	fun(args...)
+	return nil
+}
+
+// AttestPanicsWith -- like AttestPanics, but also attest that the recovered
+// value is deeply equal to expected.
+func (t *Test) AttestPanicsWith(expected interface{}, fun func(...interface{}), args ...interface{}) {
+	t.Helper()
+	recovered := t.AttestPanics(fun, args...)
+	if recovered == nil {
+		return
+	}
+	t.Attest(
+		reflect.DeepEqual(expected, recovered),
+		"Function %p panicked with %#v, expected %#v",
+		fun, recovered, expected)
+}
+
+// PanicMatches -- like AttestPanics, but also attest that the recovered value,
+// converted to a string with fmt.Sprint, matches pattern.
+func (t *Test) PanicMatches(pattern *regexp.Regexp, fun func(...interface{}), args ...interface{}) {
+	t.Helper()
+	recovered := t.AttestPanics(fun, args...)
+	if recovered == nil {
+		return
+	}
+	t.Matches(
+		pattern,
+		fmt.Sprint(recovered),
+		"Function %p panicked with %q, which didn't match pattern %v",
+		fun, fmt.Sprint(recovered), pattern)
+}
+
+// PanicsAndThen -- Attest that fun panics, and if it does, pass the recovered
+// value to inspect, which can make further assertions about it.
+func (t *Test) PanicsAndThen(fun func(), inspect func(recovered interface{})) {
+	t.Helper()
+	recovered := t.AttestPanics(func(...interface{}) { fun() })
+	if recovered != nil {
+		inspect(recovered)
+	}
 }
 
 // AttestNoPanic -- the inverse of AttestPanics
 func (t *Test) AttestNoPanic(fun func(...interface{}), args ...interface{}) {
+	t.Helper()
 	defer func() {
+		t.Helper()
 		r := recover()
-		t.Attest(r == nil, "Function %v caused a panic!", fun)
+		t.Attest(r == nil, "Function %p caused a panic!", fun)
 	}()
 	fun(args...)
 }
 
 // Handle -- log and fail for an arbitrary number of errors.
 func (t *Test) HandleMultiple(e ...error) {
+	t.Helper()
+	t.assertions++
 	for _, err := range e {
 		if err != nil {
-			t.Error(err)
+			t.errorf("%v", err)
 		}
 	}
 }
 
 // Handle -- handle an error with an optional custom message.
 func (t *Test) Handle(err error, msgAndFmt ...interface{}) {
+	t.Helper()
+	t.assertions++
 	if err == nil && len(msgAndFmt) == 0 {
 		return
 	}
 	if len(msgAndFmt) == 0 {
-		t.Error(err)
+		t.errorf("%v", err)
 		return
 	}
 	if err != nil {
@@ -69,15 +125,67 @@ func (t *Test) Handle(err error, msgAndFmt ...interface{}) {
 	}
 }
 
+// NilError -- the canonical "this should have succeeded" assertion. Fail the
+// test, without stopping it, if err is not nil. An optional message and
+// formatters replace the default message, which includes the error. Use
+// StopIf when the test can't continue after the error.
+func (t *Test) NilError(err error, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"Unexpected error: %v", err}
+	}
+	t.Attest(err == nil, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// HasError -- the inverse of NilError. Fail the test if err is nil, for when
+// an error is the expected outcome.
+func (t *Test) HasError(err error, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"expected an error but got nil"}
+	}
+	t.Attest(err != nil, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// CleanupOrHandle -- register fn to be called when the test finishes, like
+// testing.T.Cleanup, and Handle the error it returns. This is convenient for
+// closing files or connections whose Close methods return an error.
+func (t *Test) CleanupOrHandle(fn func() error) {
+	t.Helper()
+	t.Cleanup(func() {
+		t.Helper()
+		t.Handle(fn())
+	})
+}
+
+// Close -- close c, and Handle the error it returns, with an optional custom
+// message.
+func (t *Test) Close(c io.Closer, msgAndFmt ...interface{}) {
+	t.Helper()
+	err := c.Close()
+	if err != nil && len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"Error closing %#v: %v", c, err}
+	}
+	t.Handle(err, msgAndFmt...)
+}
+
+// DeferClose -- close c when the test finishes, and Handle the error it
+// returns. This replaces the unchecked "defer response.Body.Close()".
+func (t *Test) DeferClose(c io.Closer) {
+	t.Helper()
+	t.CleanupOrHandle(c.Close)
+}
+
 // StopIf -- Fail the test and stop running it if an error is present, with
 // optional message.
 func (t *Test) StopIf(err error, msgAndFmt ...interface{}) {
+	t.Helper()
+	t.assertions++
 	if err != nil {
 		if len(msgAndFmt) == 0 {
 			msgAndFmt = []interface{}{"Fatal error: %s (%#+v)", err.Error(), err}
 		}
-		log.Printf(msgAndFmt[0].(string), msgAndFmt[1:]...)
-		t.FailNow()
+		t.Fatalf(msgAndFmt[0].(string), msgAndFmt[1:]...)
 	}
 }
 
@@ -85,6 +193,8 @@ func (t *Test) StopIf(err error, msgAndFmt ...interface{}) {
 // error is not nil, the test is failed. Regardless, the first value is
 // returned through the function.
 func (t *Test) EatError(value interface{}, err error) interface{} {
+	t.Helper()
+	t.assertions++
 	if err != nil {
 		t.errorf("When aquiring value %#v, got error %s (%#+v)", value, err.Error(), err)
 	}
@@ -94,6 +204,118 @@ func (t *Test) EatError(value interface{}, err error) interface{} {
 // FailOnError accepts two values, the latter of which is a nillable error. If the
 // error is not nil, the test is failed immediately.
 func (t *Test) FailOnError(value interface{}, err error, msgAndFormat ...interface{}) interface{} {
+	t.Helper()
 	t.StopIf(err, msgAndFormat...)
 	return value
 }
+
+// ErrorIs fails the test unless err, or an error which it wraps, is target, as
+// determined by errors.Is.
+func (t *Test) ErrorIs(err, target error, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Error %#v (%v) was expected to be or to wrap %#v (%v)",
+			err, err, target, target,
+		}
+	}
+	t.Attest(errors.Is(err, target), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// ErrorIsNot is the inverse of ErrorIs.
+func (t *Test) ErrorIsNot(err, target error, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Error %#v (%v) was expected to not be or wrap %#v (%v)",
+			err, err, target, target,
+		}
+	}
+	t.AttestNot(errors.Is(err, target), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// ErrorsEqual fails the test unless the errors a and b are equivalent: either
+// is, or wraps, the other, as determined by errors.Is; or failing that, they
+// have the same message. Two nil errors are equal, but a nil error doesn't
+// equal a non-nil one. Unlike Equals, two errors created separately by
+// errors.New with the same text are equal.
+func (t *Test) ErrorsEqual(a, b error, msgAndFmt ...interface{}) {
+	t.Helper()
+	equal := errors.Is(a, b) || errors.Is(b, a) ||
+		(a != nil && b != nil && a.Error() == b.Error())
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Error %#v (%v) wasn't equal to %#v (%v)",
+			a, a, b, b,
+		}
+	}
+	t.Attest(equal, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// ErrorAs fails the test unless err, or an error which it wraps, can be
+// assigned to target, as determined by errors.As. If it can, target is set to
+// that error so it can be inspected further. target must be a non-nil pointer
+// to a type which implements error, or to an interface type; the test fails
+// if it isn't.
+func (t *Test) ErrorAs(err error, target interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	targetType := reflect.TypeOf(target)
+	if targetType == nil ||
+		targetType.Kind() != reflect.Ptr ||
+		reflect.ValueOf(target).IsNil() {
+		t.errorf("ErrorAs: target must be a non-nil pointer, got %#v (%T)", target, target)
+		return
+	}
+	if elem := targetType.Elem(); elem.Kind() != reflect.Interface &&
+		!elem.Implements(errorType) {
+		t.errorf("ErrorAs: target must be a pointer to an error type, got %T", target)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Error %#v (%v) doesn't wrap an error assignable to %s",
+			err, err, targetType.Elem(),
+		}
+	}
+	t.Attest(errors.As(err, target), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// EqualError fails the test if err is nil, or if its message isn't
+// expectedMessage.
+func (t *Test) EqualError(err error, expectedMessage string, msgAndFmt ...interface{}) {
+	t.Helper()
+	if err == nil {
+		t.errorf("Expected an error with the message %q, got nil", expectedMessage)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected an error with the message %q, got %q",
+			expectedMessage, err.Error(),
+		}
+	}
+	t.Attest(err.Error() == expectedMessage, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// ErrorContains fails the test if err is nil, or if its message doesn't
+// contain substr. This is less brittle than EqualError for errors which embed
+// variable data, like file paths.
+func (t *Test) ErrorContains(err error, substr string, msgAndFmt ...interface{}) {
+	t.Helper()
+	if err == nil {
+		t.errorf("Expected an error containing %q, got nil", substr)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected an error containing %q, got %q",
+			substr, err.Error(),
+		}
+	}
+	t.Attest(
+		strings.Contains(err.Error(), substr),
+		msgAndFmt[0].(string),
+		msgAndFmt[1:]...)
+}
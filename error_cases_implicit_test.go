@@ -7,6 +7,10 @@
 package attest
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -18,6 +22,67 @@ func TestAttestPanics(t *testing.T) {
 	)
 }
 
+func TestAttestPanicsValue(t *testing.T) {
+	test := New(t)
+	recovered := test.AttestPanics(
+		func(a ...interface{}) { panic(a[0].(string)) },
+		"test panic",
+	)
+	test.Equals("test panic", recovered)
+	test.AttestPanicsWith(
+		&codeError{500},
+		func(...interface{}) { panic(&codeError{500}) },
+	)
+	test.Attest(
+		fails(func(test *Test) {
+			test.AttestPanicsWith(
+				&codeError{500},
+				func(...interface{}) { panic(&codeError{404}) })
+		}),
+		"AttestPanicsWith passed for a different panic value")
+	test.Attest(
+		fails(func(test *Test) { test.AttestPanicsWith("panic", func(...interface{}) {}) }),
+		"AttestPanicsWith passed for a function which didn't panic")
+}
+
+func TestPanicMatches(t *testing.T) {
+	test := New(t)
+	pattern := regexp.MustCompile(`^index \d+ out of range$`)
+	test.PanicMatches(
+		pattern,
+		func(a ...interface{}) { panic(fmt.Sprintf("index %d out of range", a[0])) },
+		5)
+	test.Attest(
+		fails(func(test *Test) {
+			test.PanicMatches(pattern, func(...interface{}) { panic("something else") })
+		}),
+		"PanicMatches passed for a panic message which doesn't match")
+	test.Attest(
+		fails(func(test *Test) { test.PanicMatches(pattern, func(...interface{}) {}) }),
+		"PanicMatches passed for a function which didn't panic")
+}
+
+func TestPanicsAndThen(t *testing.T) {
+	test := New(t)
+	type payload struct {
+		Code   int
+		Reason string
+	}
+	inspected := false
+	test.PanicsAndThen(
+		func() { panic(payload{503, "unavailable"}) },
+		func(recovered interface{}) {
+			inspected = true
+			test.Equals(503, recovered.(payload).Code)
+		})
+	test.Attest(inspected, "PanicsAndThen didn't call inspect")
+	test.Attest(
+		fails(func(test *Test) {
+			test.PanicsAndThen(func() {}, func(interface{}) {})
+		}),
+		"PanicsAndThen passed for a function which didn't panic")
+}
+
 func TestAttestNoPanic(t *testing.T) {
 	test := New(t)
 	test.AttestNoPanic(
@@ -42,6 +107,173 @@ func TestFailOnError(t *testing.T) {
 	test.Equals("success", test.FailOnError(returnsNilError()).(string))
 }
 
+var errSentinel = errors.New("sentinel error")
+
+func TestCleanupOrHandle(t *testing.T) {
+	test := New(t)
+	cleanedUp := false
+	test.Run("clean", func(test *Test) {
+		test.CleanupOrHandle(func() error {
+			cleanedUp = true
+			return nil
+		})
+	})
+	test.Attest(cleanedUp, "CleanupOrHandle didn't run the cleanup function")
+	output := failureOutput(t, func(test *Test) {
+		test.CleanupOrHandle(func() error { return errSentinel })
+	})
+	test.Attest(
+		strings.Contains(output, "sentinel error"),
+		"expected the cleanup's error to fail the test, got:\n%s",
+		output)
+}
+
+func TestHasError(t *testing.T) {
+	test := New(t)
+	test.HasError(errSentinel)
+	test.Attest(
+		fails(func(test *Test) { test.HasError(nil) }),
+		"HasError passed for a nil error")
+}
+
+func TestNilError(t *testing.T) {
+	test := New(t)
+	test.NilError(nil)
+	test.NilError(nil, "with a %s", "message")
+	output := failureOutput(t, func(test *Test) {
+		test.NilError(errSentinel)
+		test.NilError(errSentinel, "custom message for %q", "sentinel")
+		test.Attest(false, "test continued after NilError")
+	})
+	test.Attest(
+		strings.Contains(output, "Unexpected error: sentinel error") &&
+			strings.Contains(output, `custom message for "sentinel"`) &&
+			strings.Contains(output, "test continued after NilError"),
+		"expected NilError to fail without stopping the test, got:\n%s",
+		output)
+}
+
+func TestErrorIs(t *testing.T) {
+	test := New(t)
+	test.ErrorIs(errSentinel, errSentinel)
+	test.ErrorIs(fmt.Errorf("wrapped: %w", errSentinel), errSentinel)
+	test.Attest(
+		fails(func(test *Test) { test.ErrorIs(errors.New("sentinel error"), errSentinel) }),
+		"ErrorIs passed for a distinct error with the same message")
+}
+
+func TestErrorIsNot(t *testing.T) {
+	test := New(t)
+	test.ErrorIsNot(errors.New("other error"), errSentinel)
+	test.ErrorIsNot(nil, errSentinel)
+	test.Attest(
+		fails(func(test *Test) {
+			test.ErrorIsNot(fmt.Errorf("wrapped: %w", errSentinel), errSentinel)
+		}),
+		"ErrorIsNot passed for a wrapped sentinel")
+}
+
+type codeError struct{ code int }
+
+func (e *codeError) Error() string { return fmt.Sprintf("error code %d", e.code) }
+
+func TestErrorAs(t *testing.T) {
+	test := New(t)
+	var target *codeError
+	test.ErrorAs(fmt.Errorf("wrapped: %w", &codeError{404}), &target)
+	test.NotNil(target, "ErrorAs didn't set the target")
+	test.Equals(404, target.code)
+	test.Attest(
+		fails(func(test *Test) { test.ErrorAs(errSentinel, &target) }),
+		"ErrorAs passed for an error chain without a *codeError")
+	test.Attest(
+		fails(func(test *Test) { test.ErrorAs(errSentinel, target) }),
+		"ErrorAs passed for a target which isn't a pointer to an error type")
+	test.Attest(
+		fails(func(test *Test) { test.ErrorAs(errSentinel, nil) }),
+		"ErrorAs passed for a nil target")
+}
+
+func TestEqualError(t *testing.T) {
+	test := New(t)
+	test.EqualError(errSentinel, "sentinel error")
+	test.EqualError(fmt.Errorf("wrapped: %w", errSentinel), "wrapped: sentinel error")
+	test.Attest(
+		fails(func(test *Test) { test.EqualError(errSentinel, "other error") }),
+		"EqualError passed for a mismatched message")
+	test.Attest(
+		fails(func(test *Test) { test.EqualError(nil, "sentinel error") }),
+		"EqualError passed for a nil error")
+}
+
+func TestErrorContains(t *testing.T) {
+	test := New(t)
+	test.ErrorContains(fmt.Errorf("opening /tmp/file: %w", errSentinel), "/tmp/file")
+	test.Attest(
+		fails(func(test *Test) { test.ErrorContains(errSentinel, "/tmp/file") }),
+		"ErrorContains passed for an absent substring")
+	test.Attest(
+		fails(func(test *Test) { test.ErrorContains(nil, "sentinel") }),
+		"ErrorContains passed for a nil error")
+}
+
+// closer counts calls to its Close method, which returns err.
+type closer struct {
+	closed int
+	err    error
+}
+
+func (c *closer) Close() error {
+	c.closed++
+	return c.err
+}
+
+func TestClose(t *testing.T) {
+	test := New(t)
+	c := new(closer)
+	test.Close(c)
+	test.Equals(1, c.closed)
+	failing := &closer{err: errSentinel}
+	test.Attest(
+		fails(func(test *Test) { test.Close(failing) }),
+		"Close passed for a Close method which returned an error")
+	test.Equals(1, failing.closed)
+	output := failureOutput(t, func(test *Test) { test.Close(&closer{err: errSentinel}) })
+	test.Attest(
+		strings.Contains(output, "sentinel error"),
+		"expected the error from Close to be reported, got:\n%s",
+		output)
+}
+
+func TestDeferClose(t *testing.T) {
+	c := new(closer)
+	t.Run("closes", func(t *testing.T) {
+		test := New(t)
+		test.DeferClose(c)
+		if c.closed != 0 {
+			t.Error("DeferClose closed before the test finished")
+		}
+	})
+	if c.closed != 1 {
+		t.Errorf("expected DeferClose to close once, closed %d times", c.closed)
+	}
+}
+
+func TestErrorsEqual(t *testing.T) {
+	test := New(t)
+	test.ErrorsEqual(errSentinel, errSentinel)
+	test.ErrorsEqual(errors.New("same text"), errors.New("same text"))
+	test.ErrorsEqual(fmt.Errorf("context: %w", errSentinel), errSentinel)
+	test.ErrorsEqual(errSentinel, fmt.Errorf("context: %w", errSentinel))
+	test.ErrorsEqual(nil, nil)
+	test.Attest(
+		fails(func(test *Test) { test.ErrorsEqual(errSentinel, errors.New("other error")) }),
+		"ErrorsEqual passed for different errors")
+	test.Attest(
+		fails(func(test *Test) { test.ErrorsEqual(errSentinel, nil) }),
+		"ErrorsEqual passed for a nil error")
+}
+
 // the following are explicit tests on the implementation, not implicit tests
 // like the others.
 func TestPanicCheckImplementationWithPanic(t *testing.T) {
@@ -0,0 +1,160 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+)
+
+// updateFlag is the name of the flag which makes MatchesGolden rewrite golden
+// files instead of comparing against them, as in
+// `go test ./... -attest.update`. It's namespaced so that it doesn't collide
+// with an -update flag defined by the package under test.
+const updateFlag = "attest.update"
+
+// userUpdateFlag is the conventional name for a golden file flag. attest
+// doesn't define it, but honors it if the package under test does.
+const userUpdateFlag = "update"
+
+func init() {
+	flag.Bool(updateFlag, false, "rewrite golden files used with attest.Test.MatchesGolden")
+}
+
+// updatingGolden reports whether the -attest.update flag, or an -update flag
+// defined by the package under test, was set.
+func updatingGolden() bool {
+	for _, name := range []string{updateFlag, userUpdateFlag} {
+		if f := flag.Lookup(name); f != nil && f.Value.String() == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesGolden fails the test unless actual is identical to the contents of
+// the file at goldenPath, showing a line-by-line diff if it isn't. When the
+// tests are run with the -update flag, the golden file is (re)written with
+// actual instead, creating its directory if need be.
+func (t *Test) MatchesGolden(goldenPath string, actual []byte, msgAndFmt ...interface{}) {
+	t.Helper()
+	if updatingGolden() {
+		err := os.MkdirAll(filepath.Dir(goldenPath), 0755)
+		if err == nil {
+			err = os.WriteFile(goldenPath, actual, 0644)
+		}
+		t.Handle(err, "Couldn't update golden file %s: %v", goldenPath, err)
+		return
+	}
+	expected, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.errorf(
+			"Couldn't read golden file %s: %v. Run the tests with -%s to create it.",
+			goldenPath, err, updateFlag)
+		return
+	}
+	matches := bytes.Equal(expected, actual)
+	if len(msgAndFmt) == 0 {
+		var diff string
+		if !matches {
+			diff = lineDiff(string(expected), string(actual))
+		}
+		msgAndFmt = []interface{}{
+			"Output didn't match golden file %s. Diff (-golden +actual):\n%s",
+			goldenPath, diff,
+		}
+	}
+	t.Attest(matches, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// FileExists fails the test if there's nothing at path, or it can't be
+// inspected.
+func (t *Test) FileExists(path string, msgAndFmt ...interface{}) {
+	t.Helper()
+	_, err := os.Stat(path)
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"Expected %s to exist: %v", path, err}
+	}
+	t.Attest(err == nil, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// FileDoesNotExist fails the test unless there's nothing at path.
+func (t *Test) FileDoesNotExist(path string, msgAndFmt ...interface{}) {
+	t.Helper()
+	_, err := os.Stat(path)
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"Expected %s to not exist", path}
+	}
+	t.Attest(os.IsNotExist(err), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// FileContents fails the test unless the file at path contains exactly
+// expected. The test also fails if the file can't be read.
+func (t *Test) FileContents(path string, expected []byte, msgAndFmt ...interface{}) {
+	t.Helper()
+	actual, err := os.ReadFile(path)
+	if err != nil {
+		t.errorf("Couldn't read %s: %v", path, err)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected %s to contain %q, but it contained %q",
+			path, expected, actual,
+		}
+	}
+	t.Attest(bytes.Equal(expected, actual), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// readDir lists the names of the entries in dir, failing the test if it can't
+// be read.
+func (t *Test) readDir(dir string) ([]string, bool) {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.errorf("Couldn't read directory %s: %v", dir, err)
+		return nil, false
+	}
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Name()
+	}
+	return names, true
+}
+
+// DirContains fails the test unless the directory dir has an entry called
+// name.
+func (t *Test) DirContains(dir, name string, msgAndFmt ...interface{}) {
+	t.Helper()
+	names, ok := t.readDir(dir)
+	if !ok {
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected directory %s to contain %q, found %q",
+			dir, name, names,
+		}
+	}
+	found, _ := contains(names, name)
+	t.Attest(found, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// DirEmpty fails the test unless the directory dir has no entries.
+func (t *Test) DirEmpty(dir string, msgAndFmt ...interface{}) {
+	t.Helper()
+	names, ok := t.readDir(dir)
+	if !ok {
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"Expected directory %s to be empty, found %q", dir, names}
+	}
+	t.Attest(len(names) == 0, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
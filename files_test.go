@@ -0,0 +1,97 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMatchesGolden(t *testing.T) {
+	test := New(t)
+	golden := filepath.Join(t.TempDir(), "output.golden")
+	test.Handle(os.WriteFile(golden, []byte("line one\nline two\n"), 0644))
+	test.MatchesGolden(golden, []byte("line one\nline two\n"))
+	output := failureOutput(t, func(test *Test) {
+		test.MatchesGolden(golden, []byte("line one\nline 2\n"))
+	})
+	test.Attest(
+		strings.Contains(output, "-line two") && strings.Contains(output, "+line 2"),
+		"expected a diff of the golden file, got:\n%s",
+		output)
+}
+
+func TestMatchesGoldenUpdate(t *testing.T) {
+	test := New(t)
+	golden := filepath.Join(t.TempDir(), "testdata", "output.golden")
+	test.Handle(flag.Set(updateFlag, "true"))
+	defer flag.Set(updateFlag, "false")
+	test.MatchesGolden(golden, []byte("updated contents"))
+	contents, err := os.ReadFile(golden)
+	test.Handle(err)
+	test.Equals("updated contents", string(contents))
+}
+
+// update is defined the way a package with its own golden files would; attest
+// must neither collide with it nor ignore it.
+var update = flag.Bool("update", false, "rewrite golden files")
+
+func TestMatchesGoldenUserUpdateFlag(t *testing.T) {
+	test := New(t)
+	golden := filepath.Join(t.TempDir(), "output.golden")
+	test.Handle(flag.Set("update", "true"))
+	defer flag.Set("update", "false")
+	test.Attest(*update, "the -update flag wasn't set")
+	test.MatchesGolden(golden, []byte("updated contents"))
+	contents, err := os.ReadFile(golden)
+	test.Handle(err)
+	test.Equals("updated contents", string(contents))
+}
+
+func TestFileAssertions(t *testing.T) {
+	test := New(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file.txt")
+	missing := filepath.Join(dir, "missing.txt")
+	test.Handle(os.WriteFile(path, []byte("contents"), 0644))
+	test.FileExists(path)
+	test.FileExists(dir)
+	test.FileDoesNotExist(missing)
+	test.FileContents(path, []byte("contents"))
+	test.Attest(
+		fails(func(test *Test) { test.FileExists(missing) }),
+		"FileExists passed for a missing file")
+	test.Attest(
+		fails(func(test *Test) { test.FileDoesNotExist(path) }),
+		"FileDoesNotExist passed for an existing file")
+	test.Attest(
+		fails(func(test *Test) { test.FileContents(path, []byte("other contents")) }),
+		"FileContents passed for different contents")
+	test.Attest(
+		fails(func(test *Test) { test.FileContents(missing, nil) }),
+		"FileContents passed for a missing file")
+}
+
+func TestDirAssertions(t *testing.T) {
+	test := New(t)
+	dir := t.TempDir()
+	test.DirEmpty(dir)
+	test.Handle(os.WriteFile(filepath.Join(dir, "known.txt"), nil, 0644))
+	test.DirContains(dir, "known.txt")
+	test.Attest(
+		fails(func(test *Test) { test.DirContains(dir, "unknown.txt") }),
+		"DirContains passed for a missing entry")
+	test.Attest(
+		fails(func(test *Test) { test.DirEmpty(dir) }),
+		"DirEmpty passed for a directory with an entry")
+	test.Attest(
+		fails(func(test *Test) { test.DirEmpty(filepath.Join(dir, "missing")) }),
+		"DirEmpty passed for a missing directory")
+}
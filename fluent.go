@@ -0,0 +1,93 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+// Assertion makes assertions about a single value, for a chainable,
+// BDD-style alternative to the methods of Test:
+//
+//	test.Value(count).GreaterThan(0).And().LessThan(10)
+//
+// Each method fails the underlying Test independently, as the equivalent Test
+// method would, and returns the Assertion so that the chain continues after a
+// failure.
+type Assertion struct {
+	t     *Test
+	value interface{}
+}
+
+// Value returns an Assertion about v.
+func (t *Test) Value(v interface{}) *Assertion {
+	return &Assertion{t: t, value: v}
+}
+
+// And returns the Assertion unchanged. It exists to make chains read more
+// naturally.
+func (a *Assertion) And() *Assertion {
+	return a
+}
+
+// Equals -- see Test.Equals.
+func (a *Assertion) Equals(expected interface{}, msgAndFmt ...interface{}) *Assertion {
+	a.t.Helper()
+	a.t.Equals(expected, a.value, msgAndFmt...)
+	return a
+}
+
+// NotEqual -- see Test.NotEqual.
+func (a *Assertion) NotEqual(other interface{}, msgAndFmt ...interface{}) *Assertion {
+	a.t.Helper()
+	a.t.NotEqual(other, a.value, msgAndFmt...)
+	return a
+}
+
+// GreaterThan fails the test unless the value is greater than than. See
+// Test.GreaterThan.
+func (a *Assertion) GreaterThan(than interface{}, msgAndFmt ...interface{}) *Assertion {
+	a.t.Helper()
+	a.t.GreaterThan(than, a.value, msgAndFmt...)
+	return a
+}
+
+// LessThan fails the test unless the value is less than than. See
+// Test.LessThan.
+func (a *Assertion) LessThan(than interface{}, msgAndFmt ...interface{}) *Assertion {
+	a.t.Helper()
+	a.t.LessThan(than, a.value, msgAndFmt...)
+	return a
+}
+
+// IsNil -- see Test.Nil.
+func (a *Assertion) IsNil(msgAndFmt ...interface{}) *Assertion {
+	a.t.Helper()
+	a.t.Nil(a.value, msgAndFmt...)
+	return a
+}
+
+// IsNotNil -- see Test.NotNil.
+func (a *Assertion) IsNotNil(msgAndFmt ...interface{}) *Assertion {
+	a.t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"Value was expected to not be nil"}
+	}
+	a.t.NotNil(a.value, msgAndFmt[0].(string), msgAndFmt[1:]...)
+	return a
+}
+
+// TypeIs -- see Test.TypeIs.
+func (a *Assertion) TypeIs(typestring string, msgAndFmt ...interface{}) *Assertion {
+	a.t.Helper()
+	a.t.TypeIs(typestring, a.value, msgAndFmt...)
+	return a
+}
+
+// Contains fails the test unless the value contains element. See
+// Test.Contains.
+func (a *Assertion) Contains(element interface{}, msgAndFmt ...interface{}) *Assertion {
+	a.t.Helper()
+	a.t.Contains(a.value, element, msgAndFmt...)
+	return a
+}
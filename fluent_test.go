@@ -0,0 +1,36 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValue(t *testing.T) {
+	test := New(t)
+	test.Value(5).Equals(5).And().GreaterThan(1).And().LessThan(10).TypeIs("int")
+	test.Value([]string{"a", "b"}).IsNotNil().Contains("b")
+	var nilPointer *int
+	test.Value(nilPointer).IsNil().And().NotEqual(new(int))
+	test.Attest(
+		fails(func(test *Test) { test.Value(5).Equals(5).And().LessThan(3) }),
+		"Value chain passed for a failed assertion")
+}
+
+func TestValueChainContinuesAfterFailure(t *testing.T) {
+	output := failureOutput(t, func(test *Test) {
+		test.Value(5).
+			Equals(5).
+			And().GreaterThan(10, "first failure").
+			And().LessThan(3, "second failure")
+	})
+	if !strings.Contains(output, "first failure") ||
+		!strings.Contains(output, "second failure") {
+		t.Errorf("expected both failures to be reported, got:\n%s", output)
+	}
+}
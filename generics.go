@@ -0,0 +1,159 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"cmp"
+	"reflect"
+)
+
+/*
+Go doesn't allow methods to have type parameters, so the generic assertions
+are top-level functions which accept the Test as their first argument.
+Because their arguments are checked at compile time, they can't fail due to
+mismatched types the way their interface{}-based counterparts can.
+*/
+
+// Equal checks that expected and actual are equal. Unlike Test.Equals, both
+// values must be of the same type, which is checked at compile time.
+func Equal[T comparable](t *Test, expected, actual T, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected %#v (%v) was actually %#v (%v)",
+			expected,
+			expected,
+			actual,
+			actual,
+		}
+	}
+	t.Attest(expected == actual, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// GreaterThanOrdered fails the test unless actual is greater than expected.
+// It works for any ordered type, including named types such as
+// `type Celsius float64`, where Test.GreaterThan would fail at runtime.
+func GreaterThanOrdered[T cmp.Ordered](t *Test, expected, actual T, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Value (%#v) was not greater than expected (%#v).",
+			actual,
+			expected,
+		}
+	}
+	t.Attest(actual > expected, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// LessThanOrdered is the counterpart of GreaterThanOrdered; it fails the test
+// unless actual is less than expected.
+func LessThanOrdered[T cmp.Ordered](t *Test, expected, actual T, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Value (%#v) was not less than expected (%#v).",
+			actual,
+			expected,
+		}
+	}
+	t.Attest(actual < expected, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// EatErr is a typed version of Test.EatError: if err is not nil the test is
+// failed, and regardless, value is returned with its static type intact, so
+// no type assertion is needed.
+func EatErr[T any](t *Test, value T, err error) T {
+	t.Helper()
+	t.EatError(value, err)
+	return value
+}
+
+// FailOnErr is a typed version of Test.FailOnError: if err is not nil the test
+// is failed and stopped immediately, otherwise value is returned with its
+// static type intact.
+func FailOnErr[T any](t *Test, value T, err error) T {
+	t.Helper()
+	t.StopIf(err)
+	return value
+}
+
+// MustOK is FailOnErr for functions which report success with a bool, like
+// map lookups and type assertions: if ok is false the test is failed and
+// stopped immediately, otherwise value is returned.
+//
+//	value, ok := m[key]
+//	value = attest.MustOK(test, value, ok, "%q wasn't in the map", key)
+func MustOK[T any](t *Test, value T, ok bool, msgAndFmt ...interface{}) T {
+	t.Helper()
+	t.assertions++
+	if !ok {
+		if len(msgAndFmt) == 0 {
+			msgAndFmt = []interface{}{"Expected ok to be true while acquiring %#v", value}
+		}
+		t.Fatalf(msgAndFmt[0].(string), msgAndFmt[1:]...)
+	}
+	return value
+}
+
+// AttestEach fails the test unless predicate returns true for every element of
+// items. The index and value of the first element it returns false for are
+// reported.
+func AttestEach[T any](t *Test, items []T, predicate func(T) bool, msgAndFmt ...interface{}) {
+	t.Helper()
+	for i, item := range items {
+		if !predicate(item) {
+			if len(msgAndFmt) == 0 {
+				msgAndFmt = []interface{}{
+					"Element %d (%#v) of %#v didn't satisfy the predicate",
+					i, item, items,
+				}
+			}
+			t.Attest(false, msgAndFmt[0].(string), msgAndFmt[1:]...)
+			return
+		}
+	}
+	t.assertions++
+}
+
+// AttestAny fails the test unless predicate returns true for at least one
+// element of items.
+func AttestAny[T any](t *Test, items []T, predicate func(T) bool, msgAndFmt ...interface{}) {
+	t.Helper()
+	found := false
+	for _, item := range items {
+		if predicate(item) {
+			found = true
+			break
+		}
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"None of the %d element(s) of %#v satisfied the predicate",
+			len(items), items,
+		}
+	}
+	t.Attest(found, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// AssertType is a checked type assertion: if value is a T, it's returned as
+// one; otherwise the test is failed and stopped immediately, rather than
+// panicking as value.(T) would.
+func AssertType[T any](t *Test, value interface{}, msgAndFmt ...interface{}) T {
+	t.Helper()
+	t.assertions++
+	asserted, ok := value.(T)
+	if !ok {
+		if len(msgAndFmt) == 0 {
+			msgAndFmt = []interface{}{
+				"Expected a value of type %s, got %#v of type %T",
+				reflect.TypeOf((*T)(nil)).Elem(), value, value,
+			}
+		}
+		t.Fatalf(msgAndFmt[0].(string), msgAndFmt[1:]...)
+	}
+	return asserted
+}
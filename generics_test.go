@@ -0,0 +1,140 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+	test := New(t)
+	Equal(&test, 5, 5)
+	Equal(&test, "a string", "a string")
+	type point struct{ X, Y int }
+	Equal(&test, point{1, 2}, point{1, 2}, "points weren't equal")
+	test.Attest(
+		fails(func(test *Test) { Equal(test, point{1, 2}, point{2, 1}) }),
+		"Equal passed for different points")
+}
+
+type celsius float64
+
+func TestGreaterThanOrdered(t *testing.T) {
+	test := New(t)
+	GreaterThanOrdered(&test, 1, 2)
+	GreaterThanOrdered(&test, 1.5, 2.5)
+	GreaterThanOrdered(&test, uint(1), uint(2))
+	GreaterThanOrdered(&test, "apple", "banana")
+	GreaterThanOrdered(&test, celsius(-4), celsius(21.5))
+	test.Attest(
+		fails(func(test *Test) { GreaterThanOrdered(test, 2, 2) }),
+		"GreaterThanOrdered passed for equal values")
+}
+
+func TestLessThanOrdered(t *testing.T) {
+	test := New(t)
+	LessThanOrdered(&test, 2, 1)
+	LessThanOrdered(&test, 2.5, 1.5)
+	LessThanOrdered(&test, uint(2), uint(1))
+	LessThanOrdered(&test, "banana", "apple")
+	LessThanOrdered(&test, celsius(21.5), celsius(-4))
+	test.Attest(
+		fails(func(test *Test) { LessThanOrdered(test, 1, 2) }),
+		"LessThanOrdered passed for a greater value")
+}
+
+func TestEatErr(t *testing.T) {
+	test := New(t)
+	value, err := returnsNilError()
+	test.Equals("success", EatErr(&test, value, err))
+	test.Attest(
+		fails(func(test *Test) { EatErr(test, "value", errors.New("failure")) }),
+		"EatErr passed for a non-nil error")
+}
+
+func TestFailOnErr(t *testing.T) {
+	test := New(t)
+	compiled, err := regexp.Compile("foo.*")
+	pattern := FailOnErr(&test, compiled, err)
+	test.Matches(pattern, "seafood")
+	test.Attest(
+		fails(func(test *Test) {
+			FailOnErr(test, 0, errors.New("failure"))
+			test.Attest(false, "FailOnErr didn't stop the test")
+		}),
+		"FailOnErr passed for a non-nil error")
+}
+
+func TestMustOK(t *testing.T) {
+	test := New(t)
+	m := map[string]int{"one": 1}
+	value, ok := m["one"]
+	test.Equals(1, MustOK(&test, value, ok))
+	var thing interface{} = "a string"
+	str, ok := thing.(string)
+	test.Equals("a string", MustOK(&test, str, ok))
+	continued := false
+	test.Attest(
+		fails(func(test *Test) {
+			value, ok := m["two"]
+			MustOK(test, value, ok)
+			continued = true
+		}),
+		"MustOK passed for a false ok")
+	test.AttestNot(continued, "MustOK didn't stop the test")
+}
+
+func TestAttestEach(t *testing.T) {
+	test := New(t)
+	positive := func(n int) bool { return n > 0 }
+	AttestEach(&test, []int{1, 2, 3}, positive)
+	AttestEach(&test, nil, positive)
+	output := failureOutput(t, func(test *Test) {
+		AttestEach(test, []int{1, 2, -3, -4}, positive)
+	})
+	test.Attest(
+		strings.Contains(output, "Element 2 (-3)"),
+		"expected the first failing element to be reported, got:\n%s",
+		output)
+}
+
+func TestAttestAny(t *testing.T) {
+	test := New(t)
+	type user struct {
+		Name  string
+		Admin bool
+	}
+	isAdmin := func(u user) bool { return u.Admin }
+	AttestAny(&test, []user{{"alice", false}, {"bob", true}}, isAdmin)
+	test.Attest(
+		fails(func(test *Test) {
+			AttestAny(test, []user{{"alice", false}, {"carol", false}}, isAdmin)
+		}),
+		"AttestAny passed when no element satisfied the predicate")
+	test.Attest(
+		fails(func(test *Test) { AttestAny(test, nil, isAdmin) }),
+		"AttestAny passed for an empty slice")
+}
+
+func TestAssertType(t *testing.T) {
+	test := New(t)
+	var value interface{} = "a string"
+	test.Equals("a string", AssertType[string](&test, value))
+	var err interface{} = errSentinel
+	test.ErrorIs(AssertType[error](&test, err), errSentinel)
+	continued := false
+	test.Attest(
+		fails(func(test *Test) {
+			AssertType[int](test, value)
+			continued = true
+		}),
+		"AssertType passed for a value of the wrong type")
+	test.AttestNot(continued, "AssertType didn't stop the test")
+}
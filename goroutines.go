@@ -0,0 +1,60 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"runtime"
+	"time"
+)
+
+// goroutineSettleTime is how long NoGoroutineLeak waits for goroutines started
+// by the function under test to exit, before considering them leaked.
+const goroutineSettleTime = 250 * time.Millisecond
+
+// goroutineSettleInterval is how often NoGoroutineLeak samples the number of
+// running goroutines while waiting for it to settle.
+const goroutineSettleInterval = 10 * time.Millisecond
+
+// settledGoroutineCount waits, for at most goroutineSettleTime, until the
+// number of running goroutines stops changing and returns it, so that
+// goroutines which are just starting or finishing aren't counted against the
+// function under test.
+func settledGoroutineCount() int {
+	count := runtime.NumGoroutine()
+	deadline := time.Now().Add(goroutineSettleTime)
+	for time.Now().Before(deadline) {
+		time.Sleep(goroutineSettleInterval)
+		next := runtime.NumGoroutine()
+		if next == count {
+			break
+		}
+		count = next
+	}
+	return count
+}
+
+// NoGoroutineLeak runs fn and fails the test if there are more goroutines
+// running afterwards than there were before. The count is allowed to settle
+// before fn is run, and goroutines are given a brief period to exit after fn
+// returns, so that ones which are just starting or finishing aren't reported.
+// Since the count is process-wide, tests which use this shouldn't be run in
+// parallel with others.
+func (t *Test) NoGoroutineLeak(fn func()) {
+	t.Helper()
+	before := settledGoroutineCount()
+	fn()
+	after := runtime.NumGoroutine()
+	deadline := time.Now().Add(goroutineSettleTime)
+	for after > before && time.Now().Before(deadline) {
+		time.Sleep(goroutineSettleInterval)
+		after = runtime.NumGoroutine()
+	}
+	t.Attest(
+		after <= before,
+		"%d goroutine(s) were leaked: %d were running before, %d after",
+		after-before, before, after)
+}
@@ -0,0 +1,31 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestNoGoroutineLeak(t *testing.T) {
+	test := New(t)
+	test.NoGoroutineLeak(func() {
+		var group sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			group.Add(1)
+			go func() { group.Done() }()
+		}
+		group.Wait()
+	})
+	blocker := make(chan struct{})
+	defer close(blocker)
+	test.Attest(
+		fails(func(test *Test) {
+			test.NoGoroutineLeak(func() { go func() { <-blocker }() })
+		}),
+		"NoGoroutineLeak passed for a function which leaked a goroutine")
+}
@@ -0,0 +1,65 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// fails reports whether fn marks the Test it's given as failed. The Test wraps
+// a testing.T which isn't attached to the running test, so its failure doesn't
+// propagate, but neither does its output; use failureOutput when the message
+// matters.
+func fails(fn func(test *Test)) bool {
+	t := new(testing.T)
+	test := New(t)
+	done := make(chan struct{})
+	// fn is run in its own goroutine so that FailNow can exit it.
+	go func() {
+		defer close(done)
+		fn(&test)
+	}()
+	<-done
+	return t.Failed()
+}
+
+/*
+Go's testing package doesn't allow a test to fail "on purpose" without failing
+the whole suite, so the failure cases of this package are exercised in a child
+process: the currently running test is re-run with failureCaseEnv set to its
+name, the child runs the failing assertions against its own testing.T, and the
+parent inspects the result.
+*/
+
+const failureCaseEnv = "ATTEST_FAILURE_CASE"
+
+// failureOutput runs fn in a child process and returns the output of that
+// process. t is failed if the child process didn't fail.
+func failureOutput(t *testing.T, fn func(test *Test)) string {
+	t.Helper()
+	if os.Getenv(failureCaseEnv) == t.Name() {
+		test := New(t)
+		fn(&test)
+		// a failed test which is then skipped is still considered failed, and
+		// skipping prevents the caller from carrying on in the child process.
+		t.SkipNow()
+	}
+	parts := strings.Split(t.Name(), "/")
+	for i, part := range parts {
+		parts[i] = "^" + part + "$"
+	}
+	cmd := exec.Command(os.Args[0], "-test.run="+strings.Join(parts, "/"), "-test.v")
+	cmd.Env = append(os.Environ(), failureCaseEnv+"="+t.Name())
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Errorf("expected %s to fail, but it passed. Output:\n%s", t.Name(), out)
+	}
+	return string(out)
+}
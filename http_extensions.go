@@ -8,9 +8,15 @@ package attest
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
 )
 
 const defaultURL = "http://example.com"
@@ -29,25 +35,18 @@ const defaultURL = "http://example.com"
 //  - The default URL is prepended to a URL which starts with "/"
 //  - The body is converted from a string with bytes.NewBufferString.
 func (t *Test) NewRecorder(params ...string) (*httptest.ResponseRecorder, *http.Request) {
+	t.Helper()
 	switch len(params) {
 	case 0:
 		return t.NewRecorder("GET", defaultURL+"/")
 	case 1:
 		return t.NewRecorder("GET", params[0])
 	case 2:
-		url := params[1]
-		if url[0] == '/' {
-			url = defaultURL + url
-		}
-		return httptest.NewRecorder(), httptest.NewRequest(params[0], url, nil)
+		return t.NewRecorderReader(params[0], params[1], nil)
 	case 3:
-		url := params[1]
-		if url[0] == '/' {
-			url = defaultURL + url
-		}
-		return httptest.NewRecorder(), httptest.NewRequest(
+		return t.NewRecorderReader(
 			params[0],
-			url,
+			params[1],
 			bytes.NewBufferString(params[2]),
 		)
 	}
@@ -58,9 +57,91 @@ func (t *Test) NewRecorder(params ...string) (*httptest.ResponseRecorder, *http.
 	return nil, nil
 }
 
+// absoluteURL prepends the default URL to url if it's only a path.
+func absoluteURL(url string) string {
+	if strings.HasPrefix(url, "/") {
+		return defaultURL + url
+	}
+	return url
+}
+
+// NewRecorderReader is like NewRecorder called with a method and URL, but
+// reads the request body from body, for payloads which aren't conveniently
+// strings, such as binary or streamed data. body may be nil.
+func (t *Test) NewRecorderReader(
+	method, url string,
+	body io.Reader,
+) (*httptest.ResponseRecorder, *http.Request) {
+	t.Helper()
+	return httptest.NewRecorder(), httptest.NewRequest(method, absoluteURL(url), body)
+}
+
+// NewRecorderJSON is like NewRecorder called with a method and URL, but the
+// request body is payload, marshaled as JSON, and the Content-Type header is
+// set to application/json. The test fails if payload can't be marshaled.
+func (t *Test) NewRecorderJSON(
+	method, url string,
+	payload interface{},
+) (*httptest.ResponseRecorder, *http.Request) {
+	t.Helper()
+	body, err := json.Marshal(payload)
+	t.Handle(err, "Couldn't marshal %#v as JSON: %v", payload, err)
+	recorder, request := t.NewRecorderReader(method, url, bytes.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	return recorder, request
+}
+
+// NewRecorderWithHeaders is like NewRecorder called with a method, URL and
+// body, but also sets each of headers on the request.
+func (t *Test) NewRecorderWithHeaders(
+	method, url, body string,
+	headers map[string]string,
+) (*httptest.ResponseRecorder, *http.Request) {
+	t.Helper()
+	recorder, request := t.NewRecorder(method, url, body)
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+	return recorder, request
+}
+
+// NewRecorderWithQuery is like NewRecorder called with a method and path, but
+// the query is encoded onto the URL, after any query the path already has.
+func (t *Test) NewRecorderWithQuery(
+	method, path string,
+	query url.Values,
+) (*httptest.ResponseRecorder, *http.Request) {
+	t.Helper()
+	return t.NewRecorder(method, withQuery(path, query))
+}
+
+// withQuery encodes query onto url, after any query it already has.
+func withQuery(url string, query url.Values) string {
+	encoded := query.Encode()
+	switch {
+	case encoded == "":
+		return url
+	case strings.Contains(url, "?"):
+		return url + "&" + encoded
+	}
+	return url + "?" + encoded
+}
+
+// ServeHTTP builds a request with NewRecorder from method, url and body, has
+// handler serve it, and returns the recorded response, ready to be checked
+// with ResponseOK, StatusCodeIs and friends.
+func (t *Test) ServeHTTP(handler http.Handler, method, url, body string) *http.Response {
+	t.Helper()
+	recorder, request := t.NewRecorder(method, url, body)
+	handler.ServeHTTP(recorder, request)
+	return recorder.Result()
+}
+
 // ResponseOK passes the test if the status code of the given response is less
-// than 400
+// than 400, that is, if it isn't a client or server error.
 func (t *Test) ResponseOK(response *http.Response, msgAndFmt ...interface{}) {
+	t.Helper()
+	t.assertions++
 	var message string
 	switch len(msgAndFmt) {
 	case 0:
@@ -68,9 +149,9 @@ func (t *Test) ResponseOK(response *http.Response, msgAndFmt ...interface{}) {
 	case 1:
 		message = msgAndFmt[0].(string)
 	default:
-		message = fmt.Sprintf(msgAndFmt[0].(string), msgAndFmt[1:len(msgAndFmt)-1]...)
+		message = fmt.Sprintf(msgAndFmt[0].(string), msgAndFmt[1:]...)
 	}
-	if response.StatusCode > 400 {
+	if response.StatusCode >= 400 {
 		t.errorf(
 			"Got status %d: %s.\n%s",
 			response.StatusCode,
@@ -79,3 +160,200 @@ func (t *Test) ResponseOK(response *http.Response, msgAndFmt ...interface{}) {
 		)
 	}
 }
+
+// readBody reads and closes the body of response, failing the test if it
+// can't be read.
+func (t *Test) readBody(response *http.Response) string {
+	t.Helper()
+	body, err := io.ReadAll(response.Body)
+	t.Handle(err)
+	t.Handle(response.Body.Close())
+	return string(body)
+}
+
+// ResponseBodyEquals reads the body of the response and fails the test unless
+// it equals expected. The body is consumed and closed.
+func (t *Test) ResponseBodyEquals(response *http.Response, expected string, msgAndFmt ...interface{}) {
+	t.Helper()
+	body := t.readBody(response)
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"Expected response body %q was actually %q", expected, body}
+	}
+	t.Attest(body == expected, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// ResponseBodyJSONEquals reads the body of the response and fails the test
+// unless it's structurally equal to the expected JSON, as with JSONEquals. The
+// body is consumed and closed.
+func (t *Test) ResponseBodyJSONEquals(response *http.Response, expected string, msgAndFmt ...interface{}) {
+	t.Helper()
+	t.JSONEquals(expected, t.readBody(response), msgAndFmt...)
+}
+
+// ResponseBodyLen reads the body of the response and fails the test unless it
+// is expected bytes long. The body is consumed and closed, so it can't be
+// checked further afterwards.
+func (t *Test) ResponseBodyLen(response *http.Response, expected int, msgAndFmt ...interface{}) {
+	t.Helper()
+	length := len(t.readBody(response))
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected a response body of %d bytes, got %d bytes",
+			expected, length,
+		}
+	}
+	t.Attest(length == expected, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// StatusCodeIs fails the test unless the status code of the response is
+// exactly expected.
+func (t *Test) StatusCodeIs(response *http.Response, expected int, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected status %d (%s), got %d (%s)",
+			expected,
+			http.StatusText(expected),
+			response.StatusCode,
+			http.StatusText(response.StatusCode),
+		}
+	}
+	t.Attest(response.StatusCode == expected, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// HeaderEquals fails the test unless the response's header key has the value
+// expected. Only the first value of the header is checked.
+func (t *Test) HeaderEquals(response *http.Response, key, expected string, msgAndFmt ...interface{}) {
+	t.Helper()
+	actual := response.Header.Get(key)
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected header %s to be %q, got %q",
+			key, expected, actual,
+		}
+	}
+	t.Attest(actual == expected, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// HeaderValues returns every value of the response's header key, where
+// Header.Get only returns the first.
+func (t *Test) HeaderValues(response *http.Response, key string) []string {
+	return response.Header.Values(key)
+}
+
+// HeaderValuesEqual fails the test unless the values of the response's header
+// key are expected, in order.
+func (t *Test) HeaderValuesEqual(response *http.Response, key string, expected []string, msgAndFmt ...interface{}) {
+	t.Helper()
+	actual := t.HeaderValues(response, key)
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected header %s to have the values %q, got %q",
+			key, expected, actual,
+		}
+	}
+	t.Attest(slices.Equal(actual, expected), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// HeaderContains fails the test unless the response's header key contains
+// substr. Only the first value of the header is checked.
+func (t *Test) HeaderContains(response *http.Response, key, substr string, msgAndFmt ...interface{}) {
+	t.Helper()
+	actual := response.Header.Get(key)
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected header %s to contain %q, got %q",
+			key, substr, actual,
+		}
+	}
+	t.Attest(
+		actual != "" && strings.Contains(actual, substr),
+		msgAndFmt[0].(string),
+		msgAndFmt[1:]...)
+}
+
+// RedirectsTo fails the test unless the response is a redirect -- its status
+// code is 3xx -- to expectedLocation, as given by its Location header.
+func (t *Test) RedirectsTo(response *http.Response, expectedLocation string, msgAndFmt ...interface{}) {
+	t.Helper()
+	location := response.Header.Get("Location")
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected a redirect to %q, got status %d (%s) with Location %q",
+			expectedLocation,
+			response.StatusCode,
+			http.StatusText(response.StatusCode),
+			location,
+		}
+	}
+	t.Attest(
+		response.StatusCode >= 300 && response.StatusCode < 400 &&
+			location == expectedLocation,
+		msgAndFmt[0].(string),
+		msgAndFmt[1:]...)
+}
+
+// findCookie returns the cookie called name which the response sets, or nil.
+func findCookie(response *http.Response, name string) *http.Cookie {
+	for _, cookie := range response.Cookies() {
+		if cookie.Name == name {
+			return cookie
+		}
+	}
+	return nil
+}
+
+// CookieSet fails the test unless the response sets a cookie called name.
+func (t *Test) CookieSet(response *http.Response, name string, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected the response to set the cookie %q, got Set-Cookie %q",
+			name, response.Header.Values("Set-Cookie"),
+		}
+	}
+	t.Attest(findCookie(response, name) != nil, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// CookieValueEquals fails the test unless the response sets a cookie called
+// name, with the value expected.
+func (t *Test) CookieValueEquals(response *http.Response, name, expected string, msgAndFmt ...interface{}) {
+	t.Helper()
+	cookie := findCookie(response, name)
+	if cookie == nil {
+		t.errorf(
+			"Expected the response to set the cookie %q, got Set-Cookie %q",
+			name, response.Header.Values("Set-Cookie"))
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected cookie %s to be %q, got %q",
+			name, expected, cookie.Value,
+		}
+	}
+	t.Attest(cookie.Value == expected, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// ContentTypeIs fails the test unless the media type of the response's
+// Content-Type header is expected. Parameters like charset are ignored, so
+// "application/json; charset=utf-8" is "application/json".
+func (t *Test) ContentTypeIs(response *http.Response, expected string, msgAndFmt ...interface{}) {
+	t.Helper()
+	header := response.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(header)
+	if err != nil {
+		t.errorf("Can't parse the Content-Type %q of the response: %v", header, err)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected Content-Type %s, got %q",
+			expected, header,
+		}
+	}
+	t.Attest(
+		strings.EqualFold(mediaType, expected),
+		msgAndFmt[0].(string),
+		msgAndFmt[1:]...)
+}
@@ -1,8 +1,13 @@
 package attest
 
 import (
+	"bytes"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strings"
 	"testing"
 )
 
@@ -44,6 +49,74 @@ func Test_NewRecorder(t *testing.T) {
 	test.TypeIs("http.noBody", req.Body)
 	test.TypeIs("*httptest.ResponseRecorder", rec)
 }
+func Test_NewRecorderWithHeaders(t *testing.T) {
+	test := New(t)
+	_, req := test.NewRecorderWithHeaders(
+		"POST",
+		"/login",
+		"user=me",
+		map[string]string{
+			"Authorization": "Bearer token",
+			"Content-Type":  "application/x-www-form-urlencoded",
+		})
+	test.Equals("POST", req.Method)
+	test.Equals("/login", req.URL.Path)
+	test.Equals("Bearer token", req.Header.Get("Authorization"))
+	test.Equals("application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+}
+func Test_NewRecorderWithQuery(t *testing.T) {
+	test := New(t)
+	_, req := test.NewRecorderWithQuery(
+		"GET",
+		"/search",
+		url.Values{"q": {"attest"}, "page": {"2"}})
+	test.Equals("GET", req.Method)
+	test.Equals("/search", req.URL.Path)
+	test.Equals("example.com", req.URL.Host)
+	test.Equals("attest", req.URL.Query().Get("q"))
+	test.Equals("2", req.URL.Query().Get("page"))
+	_, req = test.NewRecorderWithQuery("GET", "/search?sort=asc", url.Values{"q": {"a b"}})
+	test.Equals(url.Values{"sort": {"asc"}, "q": {"a b"}}, req.URL.Query())
+}
+func Test_NewRecorderReader(t *testing.T) {
+	test := New(t)
+	payload := []byte{0x1f, 0x8b, 0x00, 0xff, 0x10}
+	rec, req := test.NewRecorderReader("PUT", "/upload", bytes.NewReader(payload))
+	test.Equals("PUT", req.Method)
+	test.Equals("/upload", req.URL.Path)
+	test.Equals("example.com", req.URL.Host)
+	readBack, err := io.ReadAll(req.Body)
+	test.Handle(err)
+	test.Equals(payload, readBack)
+	test.TypeIs("*httptest.ResponseRecorder", rec)
+}
+func Test_NewRecorderJSON(t *testing.T) {
+	test := New(t)
+	payload := struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}{"widget", 3}
+	_, req := test.NewRecorderJSON("POST", "/widgets", payload)
+	test.Equals("POST", req.Method)
+	test.Equals("/widgets", req.URL.Path)
+	test.Equals("application/json", req.Header.Get("Content-Type"))
+	readBack, err := io.ReadAll(req.Body)
+	test.Handle(err)
+	test.Equals(`{"name":"widget","count":3}`, string(readBack))
+	test.Attest(
+		fails(func(test *Test) { test.NewRecorderJSON("POST", "/", make(chan int)) }),
+		"NewRecorderJSON passed for a payload which can't be marshaled")
+}
+func Test_ServeHTTP(t *testing.T) {
+	test := New(t)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		fmt.Fprintf(w, "%s %s: %s", r.Method, r.URL.Path, body)
+	})
+	res := test.ServeHTTP(handler, "POST", "/echo", "hello")
+	test.StatusCodeIs(res, http.StatusOK)
+	test.ResponseBodyEquals(res, "POST /echo: hello")
+}
 func Test_ResponseOK(t *testing.T) {
 	test := New(t)
 	rec, req := test.NewRecorder()
@@ -53,3 +126,179 @@ func Test_ResponseOK(t *testing.T) {
 	res := rec.Result()
 	test.ResponseOK(res)
 }
+
+func Test_ResponseOKBadRequest(t *testing.T) {
+	test := New(t)
+	rec, req := test.NewRecorder()
+	func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}(rec, req)
+	res := rec.Result()
+	test.Attest(
+		fails(func(test *Test) { test.ResponseOK(res) }),
+		"ResponseOK passed a %d response",
+		res.StatusCode)
+}
+
+func Test_ResponseOKMessage(t *testing.T) {
+	test := New(t)
+	output := failureOutput(t, func(test *Test) {
+		rec, req := test.NewRecorder()
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}(rec, req)
+		test.ResponseOK(rec.Result(), "fetching %s from %s", "index", "example.com")
+	})
+	test.Attest(
+		strings.Contains(output, "fetching index from example.com"),
+		"expected every formatter to be used in the message, got:\n%s",
+		output)
+}
+
+func Test_ResponseBodyEquals(t *testing.T) {
+	test := New(t)
+	rec, req := test.NewRecorder()
+	func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("known body"))
+	}(rec, req)
+	test.ResponseBodyEquals(rec.Result(), "known body")
+	test.Attest(
+		fails(func(test *Test) { test.ResponseBodyEquals(rec.Result(), "other body") }),
+		"ResponseBodyEquals passed for a different body")
+}
+
+func Test_ResponseBodyJSONEquals(t *testing.T) {
+	test := New(t)
+	rec, req := test.NewRecorder()
+	func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status": "ok", "count": 2}`))
+	}(rec, req)
+	test.ResponseBodyJSONEquals(rec.Result(), `{"count":2,"status":"ok"}`)
+}
+
+func Test_ResponseBodyLen(t *testing.T) {
+	test := New(t)
+	download := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(bytes.Repeat([]byte{0xff}, 4096))
+	})
+	test.ResponseBodyLen(test.ServeHTTP(download, "GET", "/file", ""), 4096)
+	test.Attest(
+		fails(func(test *Test) {
+			test.ResponseBodyLen(test.ServeHTTP(download, "GET", "/file", ""), 4095)
+		}),
+		"ResponseBodyLen passed for the wrong length")
+}
+
+func Test_StatusCodeIs(t *testing.T) {
+	test := New(t)
+	rec, req := test.NewRecorder("POST", "/resource")
+	func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}(rec, req)
+	test.StatusCodeIs(rec.Result(), http.StatusCreated)
+	test.Attest(
+		fails(func(test *Test) {
+			test.StatusCodeIs(rec.Result(), http.StatusInternalServerError)
+		}),
+		"StatusCodeIs passed for a mismatched status")
+}
+
+func Test_HeaderAssertions(t *testing.T) {
+	test := New(t)
+	rec, req := test.NewRecorder()
+	func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write([]byte("OK"))
+	}(rec, req)
+	res := rec.Result()
+	test.HeaderEquals(res, "Content-Type", "text/plain; charset=utf-8")
+	test.HeaderContains(res, "content-type", "text/plain")
+	test.Attest(
+		fails(func(test *Test) { test.HeaderEquals(res, "Location", "/elsewhere") }),
+		"HeaderEquals passed for a missing header")
+	test.Attest(
+		fails(func(test *Test) { test.HeaderContains(res, "Location", "") }),
+		"HeaderContains passed for a missing header")
+}
+
+func Test_RedirectsTo(t *testing.T) {
+	test := New(t)
+	redirect := http.RedirectHandler("/login", http.StatusFound)
+	res := test.ServeHTTP(redirect, "GET", "/account", "")
+	test.RedirectsTo(res, "/login")
+	test.Attest(
+		fails(func(test *Test) { test.RedirectsTo(res, "/elsewhere") }),
+		"RedirectsTo passed for a redirect to a different location")
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "/login")
+	})
+	res = test.ServeHTTP(ok, "GET", "/account", "")
+	test.Attest(
+		fails(func(test *Test) { test.RedirectsTo(res, "/login") }),
+		"RedirectsTo passed for a %d response", res.StatusCode)
+}
+
+func Test_CookieAssertions(t *testing.T) {
+	test := New(t)
+	login := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "abc123", HttpOnly: true})
+	})
+	res := test.ServeHTTP(login, "POST", "/login", "")
+	test.CookieSet(res, "session")
+	test.CookieValueEquals(res, "session", "abc123")
+	test.Attest(
+		fails(func(test *Test) { test.CookieValueEquals(res, "session", "other") }),
+		"CookieValueEquals passed for a different value")
+	test.Attest(
+		fails(func(test *Test) { test.CookieSet(res, "preferences") }),
+		"CookieSet passed for a cookie which wasn't set")
+	noCookies := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	res = test.ServeHTTP(noCookies, "POST", "/login", "")
+	test.Attest(
+		fails(func(test *Test) { test.CookieSet(res, "session") }),
+		"CookieSet passed for a response without cookies")
+	test.Attest(
+		fails(func(test *Test) { test.CookieValueEquals(res, "session", "abc123") }),
+		"CookieValueEquals passed for a response without cookies")
+}
+
+func Test_ContentTypeIs(t *testing.T) {
+	test := New(t)
+	contentType := func(value string) *http.Response {
+		return test.ServeHTTP(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", value)
+			}),
+			"GET", "/", "")
+	}
+	test.ContentTypeIs(contentType("application/json; charset=utf-8"), "application/json")
+	test.ContentTypeIs(contentType("text/html"), "text/html")
+	test.Attest(
+		fails(func(test *Test) {
+			test.ContentTypeIs(contentType("text/plain; charset=utf-8"), "application/json")
+		}),
+		"ContentTypeIs passed for a different media type")
+	test.Attest(
+		fails(func(test *Test) { test.ContentTypeIs(contentType(""), "text/plain") }),
+		"ContentTypeIs passed for a missing Content-Type")
+}
+
+func Test_HeaderValues(t *testing.T) {
+	test := New(t)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept")
+		w.Header().Add("Vary", "Accept-Encoding")
+	})
+	res := test.ServeHTTP(handler, "GET", "/", "")
+	test.Equals([]string{"Accept", "Accept-Encoding"}, test.HeaderValues(res, "vary"))
+	test.HeaderValuesEqual(res, "Vary", []string{"Accept", "Accept-Encoding"})
+	test.HeaderValuesEqual(res, "Location", nil)
+	test.Attest(
+		fails(func(test *Test) { test.HeaderValuesEqual(res, "Vary", []string{"Accept"}) }),
+		"HeaderValuesEqual passed for missing values")
+	test.Attest(
+		fails(func(test *Test) {
+			test.HeaderValuesEqual(res, "Vary", []string{"Accept-Encoding", "Accept"})
+		}),
+		"HeaderValuesEqual passed for values out of order")
+}
@@ -7,15 +7,86 @@
 package attest
 
 import (
+	"bytes"
+	"io"
 	"log"
-	"regexp"
+	"math"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestAttest(t *testing.T) {
 	test := New(t)
 	test.Attest(true, "attest.Test.Attest has failed an implicit test.")
 }
+func TestAttestFailureMessage(t *testing.T) {
+	test := New(t)
+	output := failureOutput(t, func(test *Test) {
+		test.Attest(false, "a message with %d formatter", 1)
+		test.Attest(false, "a message without formatters")
+	})
+	test.Attest(
+		strings.Contains(output, "a message with 1 formatter"),
+		"expected the formatted message in the test output, got:\n%s",
+		output)
+	test.Attest(
+		strings.Contains(output, "a message without formatters"),
+		"expected a lazy Test to continue after a failure, got:\n%s",
+		output)
+}
+func TestFailureLocation(t *testing.T) {
+	test := New(t)
+	output := failureOutput(t, func(test *Test) {
+		test.Equals(1, 2)
+	})
+	test.Attest(
+		strings.Contains(output, "implicit_tests_test.go:"),
+		"expected the failure to be reported at the caller's line, got:\n%s",
+		output)
+	test.Attest(
+		!strings.Contains(output, " tests.go:"),
+		"expected no failure to be reported inside attest, got:\n%s",
+		output)
+}
+func TestAssertionCount(t *testing.T) {
+	test := New(t)
+	defer test.ExpectAssertions(7)
+	test.Attest(true, "attest.Test.Attest has failed an implicit test.")
+	test.Nil(nil)
+	test.Handle(nil)
+	test.TypeIs("int", 5)
+	test.NotEqual(1, "1")
+	test.Refute().Equals(1, int64(1))
+	test.Never(func() bool { return false }, 10*time.Millisecond, time.Millisecond)
+	test.Attest(test.AssertionCount() == 7, "expected 7 assertions, counted %d", test.AssertionCount())
+	test.Attest(
+		fails(func(test *Test) {
+			defer test.ExpectAssertions(2)
+			test.Attest(true, "only one assertion")
+		}),
+		"ExpectAssertions passed with too few assertions")
+}
+func TestLogOnFailure(t *testing.T) {
+	called := false
+	t.Run("passes", func(t *testing.T) {
+		test := New(t)
+		test.LogOnFailure(func() string {
+			called = true
+			return "state dump"
+		})
+	})
+	if called {
+		t.Error("LogOnFailure called its function for a passing test")
+	}
+	output := failureOutput(t, func(test *Test) {
+		test.LogOnFailure(func() string { return "state dump" })
+		test.Attest(false, "failed")
+	})
+	if !strings.Contains(output, "state dump") {
+		t.Errorf("expected LogOnFailure to log for a failed test, got:\n%s", output)
+	}
+}
 func TestAttestNot(t *testing.T) {
 	test := New(t)
 	test.AttestNot(false, "attest.Test.AttestNot has failed an implicit test.")
@@ -27,15 +98,113 @@ func TestEquals(t *testing.T) {
 		"attest.Test.Equals has failed an implicit test.",
 		"attest.Test.Equals has failed an implicit test.")
 }
+func TestEqualsSlicesAndMaps(t *testing.T) {
+	test := New(t)
+	test.Equals([]int{1, 2, 3}, []int{1, 2, 3})
+	test.Equals(
+		map[string]int{"one": 1, "two": 2},
+		map[string]int{"two": 2, "one": 1})
+	type inner struct {
+		Values []string
+		Lookup map[int]bool
+	}
+	type outer struct {
+		Name  string
+		Inner inner
+	}
+	test.Equals(
+		outer{"nested", inner{[]string{"a", "b"}, map[int]bool{1: true}}},
+		outer{"nested", inner{[]string{"a", "b"}, map[int]bool{1: true}}})
+}
+func TestEqualsUnderlying(t *testing.T) {
+	test := New(t)
+	type (
+		namedInt    int
+		namedString string
+	)
+	test.EqualsUnderlying(namedInt(5), 5)
+	test.EqualsUnderlying(5, namedInt(5))
+	test.EqualsUnderlying("text", namedString("text"))
+	test.EqualsUnderlying(namedString("text"), namedString("text"))
+	test.Attest(
+		fails(func(test *Test) { test.EqualsUnderlying(namedInt(5), 6) }),
+		"EqualsUnderlying passed for different values")
+	test.Attest(
+		fails(func(test *Test) { test.EqualsUnderlying(namedString("5"), 5) }),
+		"EqualsUnderlying passed for different kinds")
+	test.Attest(
+		fails(func(test *Test) { test.EqualsUnderlying(int64(5), 5) }),
+		"EqualsUnderlying passed for different integer kinds")
+}
+func TestUncomparableValues(t *testing.T) {
+	test := New(t)
+	fn := func() {}
+	var nilFunc func()
+	m := map[string][]int{"a": {1}}
+	test.Equals(m, map[string][]int{"a": {1}})
+	test.NotEqual(m, map[string][]int{"a": {2}})
+	test.Equals(nilFunc, nilFunc)
+	test.NotEqual(fn, nilFunc)
+	test.Nil(nilFunc)
+	test.NotNil(m, "map was nil")
+	// a panic in any of these would crash the test binary
+	test.Attest(
+		fails(func(test *Test) { test.Equals(fn, fn) }),
+		"Equals passed for non-nil functions")
+	test.Attest(
+		fails(func(test *Test) { test.NotEqual(m, map[string][]int{"a": {1}}) }),
+		"NotEqual passed for equal maps")
+	test.Attest(
+		fails(func(test *Test) { test.Nil(fn) }),
+		"Nil passed for a non-nil function")
+}
+func TestFailureMessagesWithPercent(t *testing.T) {
+	output := failureOutput(t, func(test *Test) {
+		test.Equals("100%d", "100% sure")
+		test.GreaterThan("%s", "%d")
+	})
+	for _, expected := range []string{
+		`Expected "100%d" (100%d) was actually "100% sure" (100% sure)`,
+		`Value ("%d") was less than expected ("%s").`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected %q in the output, got:\n%s", expected, output)
+		}
+	}
+	if strings.Contains(output, "%!") {
+		t.Errorf("a value was formatted as a verb:\n%s", output)
+	}
+}
 func TestCompares(t *testing.T) {
 	test := NewTest(t)
 	test.Compares("987", 987)
 	test.SimilarTo([]string{"5", "6", "7"}, []int{5, 6, 7})
+	test.Compares([][]string{{"1", "2"}, {"3"}}, [2][]int{{1, 2}, {3}})
+	a, b := 0.1, 0.2
+	test.SimilarTo(a+b, 0.3)
+	test.Compares([]float64{a + b}, []float64{0.3})
+	test.Attest(
+		fails(func(test *Test) { test.SimilarTo(a+b, 0.31) }),
+		"SimilarTo passed for clearly different floats")
+	test.Attest(
+		fails(func(test *Test) { test.Compares([]string{"5", "6"}, []int{5, 6, 7}) }),
+		"Compares passed for slices of different lengths")
+	test.Attest(
+		fails(func(test *Test) { test.Compares([]string{"5 6"}, []int{5, 6}) }),
+		"Compares passed for slices which only print the same")
+	test.Attest(
+		fails(func(test *Test) { test.Compares([][]int{{1}, {2, 3}}, [][]int{{1, 2}, {3}}) }),
+		"Compares passed for nested slices of different shapes")
 }
 func TestDoesNotCompare(t *testing.T) {
 	test := NewTest(t)
 	test.DoesNotCompare("two values that", "are not the same")
 	test.NotSimilarTo(5, "var2")
+	test.DoesNotCompare([]string{"5 6"}, []int{5, 6})
+	test.DoesNotCompare(0.3, 0.31)
+	test.Attest(
+		fails(func(test *Test) { test.DoesNotCompare([]string{"5"}, []int{5}) }),
+		"DoesNotCompare passed for similar slices")
 }
 func TestAttestOrDo(t *testing.T) {
 	test := New(t)
@@ -47,6 +216,22 @@ func TestNil(t *testing.T) {
 	test := New(t)
 	test.Nil(nil, "attest.Test.Nil as failed an implicit test")
 }
+func TestNilTypedNil(t *testing.T) {
+	test := New(t)
+	var pointer *int
+	var slice []string
+	var mapping map[string]int
+	test.Nil(pointer)
+	test.Nil(slice)
+	test.Nil(mapping)
+	test.NotNil(5, "a non-nil value was considered nil")
+	test.Attest(
+		fails(func(test *Test) { test.NotNil(pointer, "typed nil") }),
+		"NotNil passed for a typed nil pointer")
+	test.Attest(
+		fails(func(test *Test) { test.Nil([]string{}) }),
+		"Nil passed for an empty, non-nil slice")
+}
 func TestNotNil(t *testing.T) {
 	test := New(t)
 	test.NotNil(
@@ -55,6 +240,43 @@ func TestNotNil(t *testing.T) {
 		"attest.Test.NotNil failed an implicit test.", //formatters not required
 	)
 }
+func TestZero(t *testing.T) {
+	test := New(t)
+	type point struct{ X, Y int }
+	var nilPointer *point
+	test.Zero(point{})
+	test.Zero(0)
+	test.Zero("")
+	test.Zero(nilPointer)
+	test.Zero(nil)
+	test.NotZero(point{1, 0})
+	test.NotZero("non-zero")
+	test.NotZero(&point{})
+	test.Attest(
+		fails(func(test *Test) { test.Zero("non-zero") }),
+		"Zero passed for a non-zero string")
+	test.Attest(
+		fails(func(test *Test) { test.NotZero(nilPointer) }),
+		"NotZero passed for a nil pointer")
+}
+func TestSame(t *testing.T) {
+	test := New(t)
+	type object struct{ Value int }
+	first := &object{1}
+	alias := first
+	second := &object{1}
+	test.Same(first, alias)
+	test.NotSame(first, second)
+	test.Attest(
+		fails(func(test *Test) { test.Same(first, second) }),
+		"Same passed for equal but distinct objects")
+	test.Attest(
+		fails(func(test *Test) { test.NotSame(first, alias) }),
+		"NotSame passed for the same object")
+	test.Attest(
+		fails(func(test *Test) { test.Same(*first, *alias) }),
+		"Same passed for non-pointer values")
+}
 func TestGreaterThan(t *testing.T) {
 	test := New(t)
 	test.GreaterThan(1, 2)
@@ -63,9 +285,26 @@ func TestGreaterThan(t *testing.T) {
 	test.GreaterThan(int16(1), int16(2))
 	test.GreaterThan(int32(1), int32(2))
 	test.GreaterThan(int64(1), int64(2))
+	test.GreaterThan(uint(1), uint(2))
+	test.GreaterThan(uint8(1), uint8(2))
+	test.GreaterThan(uint16(1), uint16(2))
+	test.GreaterThan(uint32(1), uint32(2))
+	test.GreaterThan(uint64(1), uint64(2))
+	test.GreaterThan(uintptr(1), uintptr(2))
 	test.GreaterThan(float32(1.3), float32(2.1))
 	test.GreaterThan(float64(1.3), float64(2.1))
 }
+func TestStringOrdering(t *testing.T) {
+	test := New(t)
+	test.GreaterThan("apple", "banana")
+	test.LessThan("banana", "apple")
+	test.Attest(
+		fails(func(test *Test) { test.GreaterThan("banana", "apple") }),
+		"GreaterThan passed for a string which sorts first")
+	test.Attest(
+		fails(func(test *Test) { test.LessThan("apple", "banana") }),
+		"LessThan passed for a string which sorts last")
+}
 func TestPositive(t *testing.T) {
 	test := New(t)
 	test.Positive(2)
@@ -86,6 +325,16 @@ func TestNegative(t *testing.T) {
 	test.Negative(int64(-2))
 	test.Negative(float32(-2.1))
 }
+func TestNegativeDefaultMessage(t *testing.T) {
+	test := New(t)
+	output := failureOutput(t, func(test *Test) {
+		test.Negative(2)
+	})
+	test.Attest(
+		strings.Contains(output, "2 was not negative"),
+		"expected the default message for Negative, got:\n%s",
+		output)
+}
 func TestLessThan(t *testing.T) {
 	test := New(t)
 	test.LessThan(2, 1)
@@ -94,10 +343,55 @@ func TestLessThan(t *testing.T) {
 	test.LessThan(int16(2), int16(1))
 	test.LessThan(int32(2), int32(1))
 	test.LessThan(int64(2), int64(1))
+	test.LessThan(uint(2), uint(1))
+	test.LessThan(uint8(2), uint8(1))
+	test.LessThan(uint16(2), uint16(1))
+	test.LessThan(uint32(2), uint32(1))
+	test.LessThan(uint64(2), uint64(1))
+	test.LessThan(uintptr(2), uintptr(1))
 	test.LessThan(float32(2.1), float32(1.3))
 	test.LessThan(float64(2.1), float64(1.3))
 }
 
+func TestGreaterThanOrEqual(t *testing.T) {
+	test := New(t)
+	test.GreaterThanOrEqual(1, 2)
+	test.GreaterThanOrEqual(2, 2)
+	test.GreaterThanOrEqual(uint8(7), uint8(7))
+	test.GreaterThanOrEqual(1.5, 1.5)
+	test.GreaterThanOrEqual("apple", "apple")
+	test.Attest(
+		fails(func(test *Test) { test.GreaterThanOrEqual(2, 1) }),
+		"GreaterThanOrEqual passed for a lesser value")
+	test.Attest(
+		fails(func(test *Test) { test.GreaterThanOrEqual(complex(1, 1), complex(1, 1)) }),
+		"GreaterThanOrEqual passed for complex numbers")
+	test.Attest(
+		fails(func(test *Test) { test.GreaterThanOrEqual(1, int64(2)) }),
+		"GreaterThanOrEqual passed for values of different types")
+	test.Attest(
+		fails(func(test *Test) { test.GreaterThanOrEqual(math.NaN(), 1.0) }),
+		"GreaterThanOrEqual passed for NaN")
+}
+
+func TestLessThanOrEqual(t *testing.T) {
+	test := New(t)
+	test.LessThanOrEqual(2, 1)
+	test.LessThanOrEqual(2, 2)
+	test.LessThanOrEqual(int64(-3), int64(-3))
+	test.LessThanOrEqual(float32(1.5), float32(1.5))
+	test.LessThanOrEqual("banana", "banana")
+	test.Attest(
+		fails(func(test *Test) { test.LessThanOrEqual(1, 2) }),
+		"LessThanOrEqual passed for a greater value")
+	test.Attest(
+		fails(func(test *Test) { test.LessThanOrEqual(int64(2), 1) }),
+		"LessThanOrEqual passed for values of different types")
+	test.Attest(
+		fails(func(test *Test) { test.LessThanOrEqual(1.0, math.NaN()) }),
+		"LessThanOrEqual passed for NaN")
+}
+
 func TestNotEqual(t *testing.T) {
 	test := New(t)
 	var1 := "test var 1"
@@ -110,18 +404,87 @@ func TestNotEqual(t *testing.T) {
 		"The differently-typed values %s and %d were somehow equal.",
 		var1,
 		2)
+	test.NotEqual(var1, var2)
 }
 
 func TestMatches(t *testing.T) {
 	test := New(t)
-	var pattern = test.FailOnError(regexp.Compile("foo.*")).(*regexp.Regexp)
+	var pattern = test.Regexp("foo.*")
 	var value = "seafood"
 	test.Matches(pattern, value)
 }
 
 func TestDoesNotMatch(t *testing.T) {
 	test := New(t)
-	var pattern = test.FailOnError(regexp.Compile("doesn't match")).(*regexp.Regexp)
+	var pattern = test.Regexp("doesn't match")
 	var value = "zxcvbn"
 	test.DoesNotMatch(pattern, value)
 }
+
+func TestRegexp(t *testing.T) {
+	test := New(t)
+	pattern := test.Regexp(`^\d+$`)
+	test.Equals(`^\d+$`, pattern.String())
+	test.Matches(pattern, "12345")
+	continued := false
+	test.Attest(
+		fails(func(test *Test) {
+			test.Regexp("foo(")
+			continued = true
+		}),
+		"Regexp passed for an invalid pattern")
+	test.AttestNot(continued, "Regexp didn't stop the test for an invalid pattern")
+}
+
+func TestRegexCaptures(t *testing.T) {
+	test := New(t)
+	pattern := test.Regexp(`^(?P<level>[A-Z]+) \[(\w+)\] (?P<message>.*)$`)
+	captures := test.RegexCaptures(pattern, "ERROR [db] connection refused")
+	test.Equals(map[string]string{"level": "ERROR", "message": "connection refused"}, captures)
+	test.Attest(
+		fails(func(test *Test) {
+			captures = test.RegexCaptures(pattern, "not a log line")
+		}),
+		"RegexCaptures passed for a value which doesn't match")
+	test.Empty(captures)
+}
+
+func TestMatchesString(t *testing.T) {
+	test := New(t)
+	test.MatchesString("foo.*", "seafood")
+	test.DoesNotMatchString("^foo", "seafood")
+	test.Attest(
+		fails(func(test *Test) { test.MatchesString("^foo", "seafood") }),
+		"MatchesString passed for a pattern which doesn't match")
+	test.Attest(
+		fails(func(test *Test) { test.MatchesString("foo(", "seafood") }),
+		"MatchesString passed for an invalid pattern")
+	test.Attest(
+		fails(func(test *Test) { test.DoesNotMatchString("foo(", "seafood") }),
+		"DoesNotMatchString passed for an invalid pattern")
+}
+
+func TestImplements(t *testing.T) {
+	test := New(t)
+	test.Implements((*io.Writer)(nil), new(bytes.Buffer))
+	test.Attest(
+		fails(func(test *Test) { test.Implements((*io.Writer)(nil), 5) }),
+		"Implements passed for an int")
+	test.Attest(
+		fails(func(test *Test) { test.Implements(new(bytes.Buffer), new(bytes.Buffer)) }),
+		"Implements passed for a pointer to a non-interface type")
+}
+
+func TestTypeIsLike(t *testing.T) {
+	test := New(t)
+	type point struct{ X, Y int }
+	test.TypeIsLike("", "a string")
+	test.TypeIsLike(0, 5)
+	test.TypeIsLike(point{}, point{1, 2})
+	test.Attest(
+		fails(func(test *Test) { test.TypeIsLike(0, int64(5)) }),
+		"TypeIsLike passed for an int64 and an int")
+	test.Attest(
+		fails(func(test *Test) { test.TypeIsLike(point{}, &point{}) }),
+		"TypeIsLike passed for a pointer and a struct")
+}
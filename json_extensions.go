@@ -0,0 +1,128 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// JSONEquals fails the test unless expected and actual are structurally equal
+// JSON documents. Both are unmarshaled before being compared, so key order and
+// whitespace don't matter. The test also fails if either isn't valid JSON.
+func (t *Test) JSONEquals(expected, actual string, msgAndFmt ...interface{}) {
+	t.Helper()
+	var expectedValue, actualValue interface{}
+	if err := json.Unmarshal([]byte(expected), &expectedValue); err != nil {
+		t.errorf("Expected value %q isn't valid JSON: %v", expected, err)
+		return
+	}
+	if err := json.Unmarshal([]byte(actual), &actualValue); err != nil {
+		t.errorf("Actual value %q isn't valid JSON: %v", actual, err)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"Expected JSON %s was actually %s", expected, actual}
+	}
+	t.Attest(
+		reflect.DeepEqual(expectedValue, actualValue),
+		msgAndFmt[0].(string),
+		msgAndFmt[1:]...)
+}
+
+// parseJSONPath splits a path like "data.items[0].id" into its steps: a string
+// for each object key, and an int for each array index.
+func parseJSONPath(path string) ([]interface{}, error) {
+	var steps []interface{}
+	for _, segment := range strings.Split(path, ".") {
+		if segment == "" {
+			return nil, fmt.Errorf("empty key in %q", path)
+		}
+		key := segment
+		if open := strings.IndexByte(segment, '['); open >= 0 {
+			key = segment[:open]
+		}
+		if key != "" {
+			steps = append(steps, key)
+		}
+		for rest := segment[len(key):]; rest != ""; {
+			end := strings.IndexByte(rest, ']')
+			if rest[0] != '[' || end < 0 {
+				return nil, fmt.Errorf("malformed index in %q", segment)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid index %q in %q", rest[1:end], segment)
+			}
+			steps = append(steps, index)
+			rest = rest[end+1:]
+		}
+	}
+	return steps, nil
+}
+
+// JSONPath fails the test unless body is valid JSON with a value at path
+// which is equal to expected. The path is a series of object keys separated
+// by dots, each optionally followed by array indices in brackets, such as
+// "data.items[0].id". expected is converted to JSON and back before being
+// compared, so 42 equals the JSON number 42, and a struct equals an object
+// with the same fields.
+func (t *Test) JSONPath(body []byte, path string, expected interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	var document interface{}
+	if err := json.Unmarshal(body, &document); err != nil {
+		t.errorf("Body %q isn't valid JSON: %v", body, err)
+		return
+	}
+	steps, err := parseJSONPath(path)
+	if err != nil {
+		t.errorf("Invalid JSON path %q: %v", path, err)
+		return
+	}
+	actual := document
+	for _, step := range steps {
+		switch step := step.(type) {
+		case string:
+			object, isObject := actual.(map[string]interface{})
+			value, found := object[step]
+			if !isObject || !found {
+				t.errorf("JSON path %q not found in %s: no key %q", path, body, step)
+				return
+			}
+			actual = value
+		case int:
+			array, isArray := actual.([]interface{})
+			if !isArray || step >= len(array) {
+				t.errorf("JSON path %q not found in %s: no index %d", path, body, step)
+				return
+			}
+			actual = array[step]
+		}
+	}
+	var expectedValue interface{}
+	encoded, err := json.Marshal(expected)
+	if err == nil {
+		err = json.Unmarshal(encoded, &expectedValue)
+	}
+	if err != nil {
+		t.errorf("Expected value %#v can't be converted to JSON: %v", expected, err)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected %#v at JSON path %q, got %#v",
+			expectedValue, path, actual,
+		}
+	}
+	t.Attest(
+		reflect.DeepEqual(expectedValue, actual),
+		msgAndFmt[0].(string),
+		msgAndFmt[1:]...)
+}
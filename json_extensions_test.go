@@ -0,0 +1,53 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import "testing"
+
+func TestJSONEquals(t *testing.T) {
+	test := New(t)
+	test.JSONEquals(`{"a":1,"b":2}`, `{"b":2,"a":1}`)
+	test.JSONEquals(`{"a": [1, 2, {"c": null}]}`, "{\n\t\"a\":[1,2,{\"c\":null}]\n}")
+	test.Attest(
+		fails(func(test *Test) { test.JSONEquals(`{"a":1}`, `{"a":2}`) }),
+		"JSONEquals passed for different documents")
+	test.Attest(
+		fails(func(test *Test) { test.JSONEquals(`{"a":1}`, `{"a":1`) }),
+		"JSONEquals passed for invalid JSON")
+}
+
+func TestJSONPath(t *testing.T) {
+	test := New(t)
+	body := []byte(`{
+		"data": {
+			"name": "attest",
+			"items": [{"id": 42, "tags": ["a", "b"]}, {"id": 43}],
+			"owner": null
+		}
+	}`)
+	test.JSONPath(body, "data.name", "attest")
+	test.JSONPath(body, "data.items[0].id", 42)
+	test.JSONPath(body, "data.items[0].tags[1]", "b")
+	test.JSONPath(body, "data.items[0].tags", []string{"a", "b"})
+	test.JSONPath(body, "data.owner", nil)
+	test.JSONPath([]byte(`[[1, 2], [3]]`), "[1][0]", 3)
+	test.Attest(
+		fails(func(test *Test) { test.JSONPath(body, "data.items[1].id", 42) }),
+		"JSONPath passed for the wrong value")
+	test.Attest(
+		fails(func(test *Test) { test.JSONPath(body, "data.missing", nil) }),
+		"JSONPath passed for a missing key")
+	test.Attest(
+		fails(func(test *Test) { test.JSONPath(body, "data.items[2].id", 42) }),
+		"JSONPath passed for an index out of range")
+	test.Attest(
+		fails(func(test *Test) { test.JSONPath(body, "data.items[x]", 42) }),
+		"JSONPath passed for an invalid path")
+	test.Attest(
+		fails(func(test *Test) { test.JSONPath([]byte(`{"data":`), "data", 42) }),
+		"JSONPath passed for invalid JSON")
+}
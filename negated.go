@@ -0,0 +1,111 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import "regexp"
+
+// Negated mirrors the assertions of Test, each inverted, so that
+//
+//	test.Refute().Equals(a, b)
+//
+// is equivalent to test.NotEqual(a, b). Each method takes the same arguments
+// as the Test method of the same name, and calls the inverse method of Test.
+type Negated struct {
+	t *Test
+}
+
+// Refute returns a Negated which makes inverted assertions on t.
+func (t *Test) Refute() *Negated {
+	return &Negated{t}
+}
+
+// Equals -- see Test.NotEqual.
+func (n *Negated) Equals(var1, var2 interface{}, msgAndFmt ...interface{}) {
+	n.t.Helper()
+	n.t.NotEqual(var1, var2, msgAndFmt...)
+}
+
+// Compares -- see Test.DoesNotCompare.
+func (n *Negated) Compares(var1, var2 interface{}, msgAndFmt ...interface{}) {
+	n.t.Helper()
+	n.t.DoesNotCompare(var1, var2, msgAndFmt...)
+}
+
+// Nil -- see Test.NotNil. Unlike NotNil, the message is optional.
+func (n *Negated) Nil(variable interface{}, msgAndFmt ...interface{}) {
+	n.t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"%#v was expected to not be nil", variable}
+	}
+	n.t.NotNil(variable, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// Zero -- see Test.NotZero.
+func (n *Negated) Zero(value interface{}, msgAndFmt ...interface{}) {
+	n.t.Helper()
+	n.t.NotZero(value, msgAndFmt...)
+}
+
+// Same -- see Test.NotSame.
+func (n *Negated) Same(expected, actual interface{}, msgAndFmt ...interface{}) {
+	n.t.Helper()
+	n.t.NotSame(expected, actual, msgAndFmt...)
+}
+
+// TypeIs -- see Test.TypeIsNot.
+func (n *Negated) TypeIs(typestring string, value interface{}, msgAndFmt ...interface{}) {
+	n.t.Helper()
+	n.t.TypeIsNot(typestring, value, msgAndFmt...)
+}
+
+// Contains -- see Test.DoesNotContain.
+func (n *Negated) Contains(container, element interface{}, msgAndFmt ...interface{}) {
+	n.t.Helper()
+	n.t.DoesNotContain(container, element, msgAndFmt...)
+}
+
+// Empty -- see Test.NotEmpty.
+func (n *Negated) Empty(value interface{}, msgAndFmt ...interface{}) {
+	n.t.Helper()
+	n.t.NotEmpty(value, msgAndFmt...)
+}
+
+// InRange -- see Test.NotInRange.
+func (n *Negated) InRange(low, high, value interface{}, msgAndFmt ...interface{}) {
+	n.t.Helper()
+	n.t.NotInRange(low, high, value, msgAndFmt...)
+}
+
+// Matches -- see Test.DoesNotMatch.
+func (n *Negated) Matches(pattern *regexp.Regexp, value string, msgAndFmt ...interface{}) {
+	n.t.Helper()
+	n.t.DoesNotMatch(pattern, value, msgAndFmt...)
+}
+
+// MatchesString -- see Test.DoesNotMatchString.
+func (n *Negated) MatchesString(pattern, value string, msgAndFmt ...interface{}) {
+	n.t.Helper()
+	n.t.DoesNotMatchString(pattern, value, msgAndFmt...)
+}
+
+// HasPrefix -- see Test.DoesNotHavePrefix.
+func (n *Negated) HasPrefix(s, prefix string, msgAndFmt ...interface{}) {
+	n.t.Helper()
+	n.t.DoesNotHavePrefix(s, prefix, msgAndFmt...)
+}
+
+// HasSuffix -- see Test.DoesNotHaveSuffix.
+func (n *Negated) HasSuffix(s, suffix string, msgAndFmt ...interface{}) {
+	n.t.Helper()
+	n.t.DoesNotHaveSuffix(s, suffix, msgAndFmt...)
+}
+
+// ErrorIs -- see Test.ErrorIsNot.
+func (n *Negated) ErrorIs(err, target error, msgAndFmt ...interface{}) {
+	n.t.Helper()
+	n.t.ErrorIsNot(err, target, msgAndFmt...)
+}
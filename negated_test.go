@@ -0,0 +1,35 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestRefute(t *testing.T) {
+	test := New(t)
+	test.Refute().Equals(1, 2)
+	test.Refute().Contains([]string{"a", "b"}, "c")
+	test.Refute().Matches(regexp.MustCompile(`^\d+$`), "abc")
+	test.Refute().MatchesString(`^\d+$`, "abc")
+	test.Refute().Nil(new(int))
+	test.Refute().Empty("not empty")
+	test.Refute().HasPrefix("attest", "test")
+	test.Attest(
+		fails(func(test *Test) { test.Refute().Equals(1, 1) }),
+		"Refute().Equals passed for equal values")
+	test.Attest(
+		fails(func(test *Test) { test.Refute().Contains([]string{"a", "b"}, "b") }),
+		"Refute().Contains passed for a present element")
+	test.Attest(
+		fails(func(test *Test) { test.Refute().Matches(regexp.MustCompile(`^\d+$`), "123") }),
+		"Refute().Matches passed for a matching value")
+	test.Attest(
+		fails(func(test *Test) { test.Refute().Nil(nil) }),
+		"Refute().Nil passed for nil")
+}
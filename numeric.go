@@ -0,0 +1,260 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"cmp"
+	"math"
+	"math/cmplx"
+)
+
+// DefaultEpsilon is the tolerance FloatEquals allows between two values.
+const DefaultEpsilon = 1e-9
+
+// EpsilonEquals fails the test unless actual is within epsilon of expected.
+// NaN is never equal to anything, including another NaN.
+func (t *Test) EpsilonEquals(expected, actual, epsilon float64, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected %v was actually %v, a difference of more than %v",
+			expected,
+			actual,
+			epsilon,
+		}
+	}
+	t.Attest(
+		// NaN compares false against everything, so this fails for NaN.
+		math.Abs(expected-actual) <= epsilon,
+		msgAndFmt[0].(string),
+		msgAndFmt[1:]...)
+}
+
+// FloatEquals is EpsilonEquals with an epsilon of DefaultEpsilon, for
+// comparing computed values like 0.1+0.2 which == can't.
+func (t *Test) FloatEquals(expected, actual float64, msgAndFmt ...interface{}) {
+	t.Helper()
+	t.EpsilonEquals(expected, actual, DefaultEpsilon, msgAndFmt...)
+}
+
+// Approximately fails the test unless actual is within percentTolerance
+// percent of expected; that is, unless
+//
+//	|actual - expected| <= |expected| * percentTolerance / 100
+//
+// This suits measurements whose acceptable error scales with their magnitude.
+// A percentage of zero is always zero, so when expected is zero, actual must
+// be within DefaultEpsilon of it instead. As with EpsilonEquals, NaN is never
+// approximately anything.
+func (t *Test) Approximately(expected, actual, percentTolerance float64, msgAndFmt ...interface{}) {
+	t.Helper()
+	tolerance := math.Abs(expected) * percentTolerance / 100
+	if expected == 0 {
+		tolerance = DefaultEpsilon
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected %v was actually %v, more than %v%% (%v) away",
+			expected, actual, percentTolerance, tolerance,
+		}
+	}
+	t.Attest(
+		math.Abs(actual-expected) <= tolerance,
+		msgAndFmt[0].(string),
+		msgAndFmt[1:]...)
+}
+
+// compare compares two values of the same ordered type, returning -1, 0 or +1
+// as a is less than, equal to or greater than b. ok is false if the values
+// aren't of the same type, the type isn't ordered, or either value is NaN.
+func compare(a, b interface{}) (result int, ok bool) {
+	switch a := a.(type) {
+	case int:
+		return compareTo(a, b)
+	case int8:
+		return compareTo(a, b)
+	case int16:
+		return compareTo(a, b)
+	case int32:
+		return compareTo(a, b)
+	case int64:
+		return compareTo(a, b)
+	case uint:
+		return compareTo(a, b)
+	case uint8:
+		return compareTo(a, b)
+	case uint16:
+		return compareTo(a, b)
+	case uint32:
+		return compareTo(a, b)
+	case uint64:
+		return compareTo(a, b)
+	case uintptr:
+		return compareTo(a, b)
+	case float32:
+		return compareTo(a, b)
+	case float64:
+		return compareTo(a, b)
+	case string:
+		return compareTo(a, b)
+	}
+	return 0, false
+}
+
+func compareTo[T cmp.Ordered](a T, b interface{}) (int, bool) {
+	other, ok := b.(T)
+	// NaN isn't ordered relative to anything, though cmp.Compare would sort
+	// it first
+	if !ok || a != a || other != other {
+		return 0, false
+	}
+	return cmp.Compare(a, other), true
+}
+
+// inRange reports whether value is between low and high, inclusive. ok is
+// false if the three values can't be compared.
+func inRange(low, high, value interface{}) (in, ok bool) {
+	aboveLow, lowOK := compare(value, low)
+	belowHigh, highOK := compare(value, high)
+	return aboveLow >= 0 && belowHigh <= 0, lowOK && highOK
+}
+
+// InRange fails the test unless value is between low and high, inclusive. All
+// three values must be of the same numeric (or string) type.
+func (t *Test) InRange(low, high, value interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	in, ok := inRange(low, high, value)
+	if !ok {
+		t.errorf(
+			"Can't check that %#v is in the range [%#v, %#v]: types %T, %T and "+
+				"%T can't be compared.",
+			value, low, high, value, low, high)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Value (%#v) was outside of the range [%#v, %#v]",
+			value,
+			low,
+			high,
+		}
+	}
+	t.Attest(in, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// NotInRange is the inverse of InRange.
+func (t *Test) NotInRange(low, high, value interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	in, ok := inRange(low, high, value)
+	if !ok {
+		t.errorf(
+			"Can't check that %#v is outside the range [%#v, %#v]: types %T, %T "+
+				"and %T can't be compared.",
+			value, low, high, value, low, high)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Value (%#v) was within the range [%#v, %#v]",
+			value,
+			low,
+			high,
+		}
+	}
+	t.AttestNot(in, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// magnitude returns the absolute value of a complex64 or complex128. ok is
+// false for any other type.
+func magnitude(value interface{}) (abs float64, ok bool) {
+	switch value := value.(type) {
+	case complex64:
+		return cmplx.Abs(complex128(value)), true
+	case complex128:
+		return cmplx.Abs(value), true
+	}
+	return 0, false
+}
+
+// GreaterMagnitude fails the test unless the absolute value of the complex
+// number variable is greater than that of expected. This is the closest thing
+// to GreaterThan which complex numbers allow.
+func (t *Test) GreaterMagnitude(expected, variable interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	expectedAbs, expectedOK := magnitude(expected)
+	variableAbs, variableOK := magnitude(variable)
+	if !expectedOK || !variableOK {
+		t.errorf(
+			"Can't compare the magnitudes of %#v and %#v: found non-complex types "+
+				"%T and %T.",
+			expected, variable, expected, variable)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Magnitude of %v (%v) was not greater than that of %v (%v).",
+			variable, variableAbs, expected, expectedAbs,
+		}
+	}
+	t.Attest(variableAbs > expectedAbs, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// LessMagnitude is the counterpart of GreaterMagnitude; it fails unless the
+// absolute value of variable is less than that of expected.
+func (t *Test) LessMagnitude(expected, variable interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	expectedAbs, expectedOK := magnitude(expected)
+	variableAbs, variableOK := magnitude(variable)
+	if !expectedOK || !variableOK {
+		t.errorf(
+			"Can't compare the magnitudes of %#v and %#v: found non-complex types "+
+				"%T and %T.",
+			expected, variable, expected, variable)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Magnitude of %v (%v) was not less than that of %v (%v).",
+			variable, variableAbs, expected, expectedAbs,
+		}
+	}
+	t.Attest(variableAbs < expectedAbs, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// Increments fails the test unless after is greater than before, such as when
+// a counter was read before and after some action. Both values must be of the
+// same numeric type.
+func (t *Test) Increments(before, after interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	t.changes(before, after, 1, "increase", msgAndFmt)
+}
+
+// Decrements is the reverse of Increments: it fails the test unless after is
+// less than before.
+func (t *Test) Decrements(before, after interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	t.changes(before, after, -1, "decrease", msgAndFmt)
+}
+
+// changes fails the test unless comparing after to before gives direction.
+func (t *Test) changes(before, after interface{}, direction int, verb string, msgAndFmt []interface{}) {
+	t.Helper()
+	result, ok := compare(after, before)
+	if !ok {
+		t.errorf(
+			"Can't check that %#v would %s to %#v: types %T and %T can't be compared.",
+			before, verb, after, before, after)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected the value to %s from %#v, but it was %#v",
+			verb, before, after,
+		}
+	}
+	t.Attest(result == direction, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
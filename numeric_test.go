@@ -0,0 +1,125 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEpsilonEquals(t *testing.T) {
+	test := New(t)
+	test.EpsilonEquals(1.0, 1.05, 0.1)
+	test.FloatEquals(0.3, 0.1+0.2)
+	test.Attest(
+		fails(func(test *Test) { test.EpsilonEquals(1.0, 1.1001, 0.1) }),
+		"EpsilonEquals passed for a value outside epsilon")
+	test.Attest(
+		fails(func(test *Test) { test.FloatEquals(math.NaN(), math.NaN()) }),
+		"FloatEquals passed for NaN")
+}
+
+func TestApproximately(t *testing.T) {
+	test := New(t)
+	test.Approximately(200, 201.9, 1)
+	test.Approximately(-200, -198.1, 1)
+	test.Approximately(0, 0, 1)
+	test.Attest(
+		fails(func(test *Test) { test.Approximately(200, 202.1, 1) }),
+		"Approximately passed for a value just outside the tolerance")
+	test.Attest(
+		fails(func(test *Test) { test.Approximately(0, 0.001, 50) }),
+		"Approximately passed for a value outside epsilon of zero")
+}
+
+func TestInRange(t *testing.T) {
+	test := New(t)
+	test.InRange(1, 10, 5)
+	test.InRange(1, 10, 1)
+	test.InRange(1, 10, 10)
+	test.InRange(-1.5, 1.5, 0.0)
+	test.Attest(
+		fails(func(test *Test) { test.InRange(1, 10, 0) }),
+		"InRange passed for a value below the range")
+	test.Attest(
+		fails(func(test *Test) { test.InRange(-1.5, 1.5, 1.6) }),
+		"InRange passed for a value above the range")
+	test.Attest(
+		fails(func(test *Test) { test.InRange(1, 10, 5.0) }),
+		"InRange passed for mismatched types")
+	test.Attest(
+		fails(func(test *Test) { test.InRange(10.0, 5.0, math.NaN()) }),
+		"InRange passed for NaN")
+	test.Attest(
+		fails(func(test *Test) { test.InRange(math.NaN(), 10.0, 5.0) }),
+		"InRange passed for a NaN bound")
+}
+
+func TestNotInRange(t *testing.T) {
+	test := New(t)
+	test.NotInRange(1, 10, 0)
+	test.NotInRange(1, 10, 11)
+	test.NotInRange(-1.5, 1.5, -1.6)
+	test.Attest(
+		fails(func(test *Test) { test.NotInRange(1, 10, 5) }),
+		"NotInRange passed for a value within the range")
+}
+
+func TestGreaterMagnitude(t *testing.T) {
+	test := New(t)
+	test.GreaterMagnitude(complex(1, 1), complex(3, 4))
+	test.GreaterMagnitude(complex64(complex(1, 1)), complex64(complex(-3, 4)))
+	test.Attest(
+		fails(func(test *Test) { test.GreaterMagnitude(complex(3, 4), complex(4, 3)) }),
+		"GreaterMagnitude passed for equal magnitudes")
+	test.Attest(
+		fails(func(test *Test) { test.GreaterThan(complex(1, 1), complex(3, 4)) }),
+		"GreaterThan passed for complex numbers")
+}
+
+func TestLessMagnitude(t *testing.T) {
+	test := New(t)
+	test.LessMagnitude(complex(3, 4), complex(1, 1))
+	test.LessMagnitude(complex64(complex(3, -4)), complex64(complex(1, 1)))
+	test.Attest(
+		fails(func(test *Test) { test.LessMagnitude(1, 2) }),
+		"LessMagnitude passed for non-complex types")
+}
+
+func TestIncrements(t *testing.T) {
+	test := New(t)
+	test.Increments(1, 2)
+	test.Increments(uint64(0), uint64(10))
+	test.Increments(0.5, 0.75)
+	test.Attest(
+		fails(func(test *Test) { test.Increments(3, 3) }),
+		"Increments passed for an unchanged value")
+	test.Attest(
+		fails(func(test *Test) { test.Increments(3, 2) }),
+		"Increments passed for a decrement")
+	test.Attest(
+		fails(func(test *Test) { test.Increments(1, int64(2)) }),
+		"Increments passed for values of different types")
+	test.Attest(
+		fails(func(test *Test) { test.Increments(math.NaN(), 1.0) }),
+		"Increments passed from NaN")
+}
+
+func TestDecrements(t *testing.T) {
+	test := New(t)
+	test.Decrements(2, 1)
+	test.Decrements(float32(1), float32(-1))
+	test.Attest(
+		fails(func(test *Test) { test.Decrements(3, 3) }),
+		"Decrements passed for an unchanged value")
+	test.Attest(
+		fails(func(test *Test) { test.Decrements(2, 3) }),
+		"Decrements passed for an increment")
+	test.Attest(
+		fails(func(test *Test) { test.Decrements(1.0, math.NaN()) }),
+		"Decrements passed to NaN")
+}
@@ -0,0 +1,104 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+)
+
+// RequestBuilder builds an httptest.ResponseRecorder and http.Request pair,
+// like NewRecorder, from chained calls for each option:
+//
+//	recorder, request := test.Request().
+//		Method("POST").
+//		URL("/widgets").
+//		Header("Authorization", "Bearer token").
+//		JSON(widget).
+//		Build()
+//
+// Unless they're set, the method is GET and the URL is the index of the
+// default URL.
+type RequestBuilder struct {
+	t       *Test
+	method  string
+	url     string
+	body    []byte
+	headers http.Header
+	query   url.Values
+}
+
+// Request returns a new RequestBuilder.
+func (t *Test) Request() *RequestBuilder {
+	return &RequestBuilder{
+		t:       t,
+		method:  "GET",
+		url:     "/",
+		headers: make(http.Header),
+		query:   make(url.Values),
+	}
+}
+
+// Method sets the method of the request.
+func (b *RequestBuilder) Method(method string) *RequestBuilder {
+	b.method = method
+	return b
+}
+
+// URL sets the URL of the request. As with NewRecorder, the default URL is
+// prepended to a URL which starts with "/".
+func (b *RequestBuilder) URL(url string) *RequestBuilder {
+	b.url = url
+	return b
+}
+
+// Body sets the body of the request.
+func (b *RequestBuilder) Body(body string) *RequestBuilder {
+	b.body = []byte(body)
+	return b
+}
+
+// JSON sets the body of the request to payload, marshaled as JSON, and the
+// Content-Type header to application/json. The test fails if payload can't be
+// marshaled.
+func (b *RequestBuilder) JSON(payload interface{}) *RequestBuilder {
+	b.t.Helper()
+	body, err := json.Marshal(payload)
+	b.t.Handle(err, "Couldn't marshal %#v as JSON: %v", payload, err)
+	b.body = body
+	b.headers.Set("Content-Type", "application/json")
+	return b
+}
+
+// Header adds a value for the header key of the request.
+func (b *RequestBuilder) Header(key, value string) *RequestBuilder {
+	b.headers.Add(key, value)
+	return b
+}
+
+// Query adds a value for the query parameter key of the request, after any
+// query already in its URL.
+func (b *RequestBuilder) Query(key, value string) *RequestBuilder {
+	b.query.Add(key, value)
+	return b
+}
+
+// Build returns the ResponseRecorder and Request pair.
+func (b *RequestBuilder) Build() (*httptest.ResponseRecorder, *http.Request) {
+	b.t.Helper()
+	recorder, request := b.t.NewRecorderReader(
+		b.method,
+		withQuery(b.url, b.query),
+		bytes.NewReader(b.body))
+	for key, values := range b.headers {
+		request.Header[key] = values
+	}
+	return recorder, request
+}
@@ -0,0 +1,52 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"io"
+	"testing"
+)
+
+func Test_Request(t *testing.T) {
+	test := New(t)
+	rec, req := test.Request().
+		Method("POST").
+		URL("/widgets?dry_run=true").
+		Header("Authorization", "Bearer token").
+		Header("Accept", "application/json").
+		Query("page", "2").
+		Query("tag", "new").
+		JSON(map[string]int{"count": 3}).
+		Build()
+	test.Equals("POST", req.Method)
+	test.Equals("example.com", req.URL.Host)
+	test.Equals("/widgets", req.URL.Path)
+	test.Equals("true", req.URL.Query().Get("dry_run"))
+	test.Equals("2", req.URL.Query().Get("page"))
+	test.Equals("new", req.URL.Query().Get("tag"))
+	test.Equals("Bearer token", req.Header.Get("Authorization"))
+	test.Equals("application/json", req.Header.Get("Accept"))
+	test.Equals("application/json", req.Header.Get("Content-Type"))
+	body, err := io.ReadAll(req.Body)
+	test.Handle(err)
+	test.Equals(`{"count":3}`, string(body))
+	test.TypeIs("*httptest.ResponseRecorder", rec)
+}
+
+func Test_RequestDefaults(t *testing.T) {
+	test := New(t)
+	_, req := test.Request().Body("plain").Build()
+	test.Equals("GET", req.Method)
+	test.Equals("/", req.URL.Path)
+	test.Equals("example.com", req.URL.Host)
+	body, err := io.ReadAll(req.Body)
+	test.Handle(err)
+	test.Equals("plain", string(body))
+	test.Attest(
+		fails(func(test *Test) { test.Request().JSON(make(chan int)) }),
+		"Request().JSON passed for a payload which can't be marshaled")
+}
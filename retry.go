@@ -0,0 +1,43 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import "time"
+
+// Retry calls fn up to attempts times, until it returns nil. If every attempt
+// returns an error, the test is failed with the last one, which is returned.
+// This is intended for integration tests against inherently flaky resources.
+func (t *Test) Retry(attempts int, fn func() error) error {
+	t.Helper()
+	return t.retry(attempts, 0, fn)
+}
+
+// RetryWithBackoff is like Retry, but waits between attempts: initially for
+// delay, then twice as long after each failure.
+func (t *Test) RetryWithBackoff(attempts int, delay time.Duration, fn func() error) error {
+	t.Helper()
+	return t.retry(attempts, delay, fn)
+}
+
+func (t *Test) retry(attempts int, delay time.Duration, fn func() error) (err error) {
+	t.Helper()
+	if attempts < 1 {
+		t.errorf("Can't retry a function %d times", attempts)
+		return nil
+	}
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err = fn(); err == nil {
+			break
+		}
+		if attempt < attempts && delay > 0 {
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}
+	t.Attest(err == nil, "Failed after %d attempt(s): %v", attempts, err)
+	return err
+}
@@ -0,0 +1,61 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// succeedsOn returns a function which fails until it's called for the nth
+// time, and the number of calls made to it.
+func succeedsOn(n int) (fn func() error, calls *int) {
+	calls = new(int)
+	return func() error {
+		*calls++
+		if *calls < n {
+			return errSentinel
+		}
+		return nil
+	}, calls
+}
+
+func TestRetry(t *testing.T) {
+	test := New(t)
+	fn, calls := succeedsOn(3)
+	test.NilError(test.Retry(5, fn))
+	test.Equals(3, *calls)
+	var err error
+	test.Attest(
+		fails(func(test *Test) {
+			fn, _ := succeedsOn(4)
+			err = test.Retry(3, fn)
+		}),
+		"Retry passed for a function which never succeeded")
+	test.ErrorIs(err, errSentinel)
+	test.Attest(
+		fails(func(test *Test) { test.Retry(0, func() error { return nil }) }),
+		"Retry passed for zero attempts")
+}
+
+func TestRetryWithBackoff(t *testing.T) {
+	test := New(t)
+	fn, calls := succeedsOn(3)
+	start := time.Now()
+	test.NilError(test.RetryWithBackoff(3, 5*time.Millisecond, fn))
+	test.Equals(3, *calls)
+	// 5ms before the second attempt, then 10ms before the third
+	test.DurationGreaterThan(14*time.Millisecond, time.Since(start))
+	test.Attest(
+		fails(func(test *Test) {
+			test.RetryWithBackoff(2, time.Millisecond, func() error {
+				return errors.New("always fails")
+			})
+		}),
+		"RetryWithBackoff passed for a function which never succeeded")
+}
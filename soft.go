@@ -0,0 +1,37 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import "strings"
+
+// SoftTest is a Test whose failed assertions are recorded rather than failing
+// the test, until Finish is called. This is useful when validating many fields
+// of a large value, where every failure should be seen at once.
+type SoftTest struct {
+	Test
+}
+
+// Soft returns a SoftTest which reports its failures to the same testing.T
+// as t when its Finish method is called. Fatal methods like StopIf still stop
+// the test immediately.
+func (t *Test) Soft() *SoftTest {
+	return &SoftTest{Test{soft: new([]string), T: t.T}}
+}
+
+// Finish fails the test with every failure recorded since the SoftTest was
+// created, or since Finish was last called. It's convenient to defer.
+func (t *SoftTest) Finish() {
+	t.Helper()
+	failures := *t.soft
+	*t.soft = nil
+	if len(failures) > 0 {
+		t.Errorf(
+			"%d soft assertion(s) failed:\n%s",
+			len(failures),
+			strings.Join(failures, "\n"))
+	}
+}
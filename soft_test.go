@@ -0,0 +1,40 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSoft(t *testing.T) {
+	test := New(t)
+	soft := test.Soft()
+	soft.Equals(1, 1)
+	soft.Contains("seafood", "foo")
+	soft.Finish()
+	test.Not(
+		fails(func(test *Test) {
+			soft := test.Soft()
+			soft.Equals(1, 2)
+		}),
+		"a SoftTest failed before Finish was called")
+	output := failureOutput(t, func(test *Test) {
+		soft := test.Soft()
+		defer soft.Finish()
+		soft.Equals("first", "value")
+		soft.Attest(false, "second failure")
+		soft.Handle(errSentinel)
+	})
+	test.Attest(
+		strings.Contains(output, "3 soft assertion(s) failed") &&
+			strings.Contains(output, `"first"`) &&
+			strings.Contains(output, "second failure") &&
+			strings.Contains(output, "sentinel error"),
+		"expected every soft failure to be reported by Finish, got:\n%s",
+		output)
+}
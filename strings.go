@@ -0,0 +1,58 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import "strings"
+
+// HasPrefix fails the test unless s begins with prefix.
+func (t *Test) HasPrefix(s, prefix string, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"%q didn't begin with %q", s, prefix}
+	}
+	t.Attest(strings.HasPrefix(s, prefix), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// DoesNotHavePrefix is the inverse of HasPrefix.
+func (t *Test) DoesNotHavePrefix(s, prefix string, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"%q was expected to not begin with %q", s, prefix}
+	}
+	t.AttestNot(strings.HasPrefix(s, prefix), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// HasSuffix fails the test unless s ends with suffix.
+func (t *Test) HasSuffix(s, suffix string, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"%q didn't end with %q", s, suffix}
+	}
+	t.Attest(strings.HasSuffix(s, suffix), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// DoesNotHaveSuffix is the inverse of HasSuffix.
+func (t *Test) DoesNotHaveSuffix(s, suffix string, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"%q was expected to not end with %q", s, suffix}
+	}
+	t.AttestNot(strings.HasSuffix(s, suffix), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// EqualsFold fails the test unless expected and actual are equal, ignoring
+// case, as determined by strings.EqualFold.
+func (t *Test) EqualsFold(expected, actual string, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected %q was actually %q, even ignoring case",
+			expected, actual,
+		}
+	}
+	t.Attest(strings.EqualFold(expected, actual), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
@@ -0,0 +1,42 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import "testing"
+
+func TestHasPrefix(t *testing.T) {
+	test := New(t)
+	test.HasPrefix("seafood", "sea")
+	test.DoesNotHavePrefix("seafood", "food")
+	test.Attest(
+		fails(func(test *Test) { test.HasPrefix("seafood", "food") }),
+		"HasPrefix passed for a missing prefix")
+	test.Attest(
+		fails(func(test *Test) { test.DoesNotHavePrefix("seafood", "sea") }),
+		"DoesNotHavePrefix passed for a present prefix")
+}
+
+func TestHasSuffix(t *testing.T) {
+	test := New(t)
+	test.HasSuffix("seafood", "food")
+	test.DoesNotHaveSuffix("seafood", "sea")
+	test.Attest(
+		fails(func(test *Test) { test.HasSuffix("seafood", "sea") }),
+		"HasSuffix passed for a missing suffix")
+	test.Attest(
+		fails(func(test *Test) { test.DoesNotHaveSuffix("seafood", "food") }),
+		"DoesNotHaveSuffix passed for a present suffix")
+}
+
+func TestEqualsFold(t *testing.T) {
+	test := New(t)
+	test.EqualsFold("Hello", "hello")
+	test.EqualsFold("APPLICATION/JSON", "application/json")
+	test.Attest(
+		fails(func(test *Test) { test.EqualsFold("Hello", "world") }),
+		"EqualsFold passed for different strings")
+}
@@ -0,0 +1,66 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// Run runs fn as a subtest of t called name, like testing.T.Run, but passes fn
+// a Test wrapping the subtest, so it doesn't need to be wrapped again. The
+// subtest fails lazily or immediately like t does. Run reports whether the
+// subtest succeeded.
+func (t *Test) Run(name string, fn func(t *Test)) bool {
+	t.Helper()
+	return t.T.Run(name, func(child *testing.T) {
+		test := Test{hardFail: t.hardFail, T: child}
+		fn(&test)
+	})
+}
+
+// caseName derives a subtest name for the case at index: the value of its
+// Name field, if it's a struct with a non-empty string field called Name, or
+// "case_<index>" otherwise.
+func caseName(index int, testCase interface{}) string {
+	value := reflect.Indirect(reflect.ValueOf(testCase))
+	if value.Kind() == reflect.Struct {
+		name := value.FieldByName("Name")
+		if name.IsValid() && name.Kind() == reflect.String && name.String() != "" {
+			return name.String()
+		}
+	}
+	return fmt.Sprintf("case_%d", index)
+}
+
+// Cases runs fn once for each of cases, each as its own subtest, so that a
+// failure in one case doesn't affect the others. Subtests are named after the
+// Name field of the case, if it has one. A typical table-driven test looks
+// like:
+//
+//	attest.Cases(&test, []struct {
+//		Name          string
+//		Input, Output int
+//	}{
+//		{"zero", 0, 0},
+//		{"positive", 2, 4},
+//	}, func(test *attest.Test, c struct {
+//		Name          string
+//		Input, Output int
+//	}) {
+//		test.Equals(c.Output, Double(c.Input))
+//	})
+func Cases[T any](t *Test, cases []T, fn func(*Test, T)) {
+	t.Helper()
+	for index, testCase := range cases {
+		testCase := testCase
+		t.Run(caseName(index, testCase), func(test *Test) {
+			fn(test, testCase)
+		})
+	}
+}
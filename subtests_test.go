@@ -0,0 +1,76 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"strings"
+	"testing"
+)
+
+type doublingCase struct {
+	Name          string
+	Input, Output int
+}
+
+func TestCases(t *testing.T) {
+	test := New(t)
+	var names []string
+	Cases(&test, []doublingCase{
+		{"zero", 0, 0},
+		{"positive", 2, 4},
+		{"negative", -3, -6},
+	}, func(test *Test, c doublingCase) {
+		names = append(names, test.Name())
+		test.Equals(c.Output, c.Input*2)
+	})
+	test.Equals(
+		[]string{"TestCases/zero", "TestCases/positive", "TestCases/negative"},
+		names)
+	names = nil
+	Cases(&test, []int{1, 2}, func(test *Test, c int) {
+		names = append(names, test.Name())
+	})
+	test.Equals([]string{"TestCases/case_0", "TestCases/case_1"}, names)
+}
+
+func TestCasesIsolation(t *testing.T) {
+	test := New(t)
+	output := failureOutput(t, func(test *Test) {
+		Cases(test, []doublingCase{
+			{"bad", 2, 5},
+			{"good", 2, 4},
+		}, func(test *Test, c doublingCase) {
+			test.Equals(c.Output, c.Input*2)
+		})
+	})
+	test.Attest(
+		strings.Contains(output, "--- FAIL: TestCasesIsolation/bad") &&
+			strings.Contains(output, "--- PASS: TestCasesIsolation/good"),
+		"expected only the failing case to fail, got:\n%s",
+		output)
+}
+
+func TestRun(t *testing.T) {
+	test := New(t)
+	passed := test.Run("passing", func(test *Test) {
+		test.Equals("TestRun/passing", test.Name())
+	})
+	test.Attest(passed, "Run reported a passing subtest as failed")
+	output := failureOutput(t, func(test *Test) {
+		test.Run("failing", func(test *Test) {
+			test.Attest(false, "this subtest fails")
+		})
+		test.Run("isolated", func(test *Test) {
+			test.Attest(true, "this subtest passes")
+		})
+	})
+	test.Attest(
+		strings.Contains(output, "--- FAIL: TestRun/failing") &&
+			strings.Contains(output, "--- PASS: TestRun/isolated"),
+		"expected only the failing subtest to fail, got:\n%s",
+		output)
+}
@@ -102,7 +102,8 @@ package attest
 
 import (
 	"fmt"
-	"log"
+	"math"
+	"reflect"
 	"regexp"
 	"testing"
 )
@@ -113,7 +114,7 @@ import (
 // assertion fails. This behavior can be toggled by calling .ImmediateFailure()
 // on the returned Test.
 func New(t *testing.T) Test {
-	return Test{false, t}
+	return Test{T: t}
 }
 
 // NewTest does the same thing as New
@@ -124,13 +125,18 @@ func NewTest(t *testing.T) Test {
 // NewImmediate returns a Test which will fail at the first error by default.
 // This can be toggled by calling .LazyFailure() on the returned Test.
 func NewImmediate(t *testing.T) Test {
-	return Test{true, t}
+	return Test{hardFail: true, T: t}
 }
 
 // Test -- A structure for containing methods and data for asserting and
 // testing assertion validity
 type Test struct {
 	hardFail bool
+	// assertions counts the checks made by this Test. See AssertionCount.
+	assertions int
+	// soft collects failure messages in place of reporting them, when not nil.
+	// See Soft.
+	soft *[]string
 	*testing.T
 }
 
@@ -139,6 +145,11 @@ func typeOf(val interface{}) string {
 }
 
 func (t *Test) fail() {
+	t.Helper()
+	if t.soft != nil {
+		*t.soft = append(*t.soft, "assertion failed")
+		return
+	}
 	if t.hardFail {
 		t.FailNow()
 	} else {
@@ -147,10 +158,15 @@ func (t *Test) fail() {
 }
 
 func (t *Test) errorf(msg string, formatters ...interface{}) {
+	t.Helper()
+	if t.soft != nil {
+		*t.soft = append(*t.soft, fmt.Sprintf(msg, formatters...))
+		return
+	}
 	if t.hardFail {
-		t.Errorf(msg, formatters...)
-	} else {
 		t.Fatalf(msg, formatters...)
+	} else {
+		t.Errorf(msg, formatters...)
 	}
 }
 
@@ -162,20 +178,56 @@ func (t *Test) LazyFailure() {
 	t.hardFail = false
 }
 
-// Equals checks that var1 is deeply equal to var2. Optionally, you can pass an
-// additional string and additional string formatters to be passed to
+// AssertionCount returns the number of checks this Test has made. Assertions
+// which are built from others count each of the checks they make; Equals, for
+// example, checks both the type and the value of its arguments, and counts
+// twice.
+func (t *Test) AssertionCount() int {
+	return t.assertions
+}
+
+// ExpectAssertions fails the test if fewer than n checks have been made. It's
+// intended to be deferred at the start of a test, to guard against tests which
+// accidentally assert nothing:
+//
+//	test := attest.New(t)
+//	defer test.ExpectAssertions(3)
+func (t *Test) ExpectAssertions(n int) {
+	t.Helper()
+	if t.assertions < n {
+		t.errorf("Expected at least %d assertions, but only %d were made.", n, t.assertions)
+	}
+}
+
+// LogOnFailure registers fn to be called when the test finishes, and its
+// result logged, only if the test failed. This is a cheap way to attach a dump
+// of state which would only be noise when the test passes.
+func (t *Test) LogOnFailure(fn func() string) {
+	t.Helper()
+	t.Cleanup(func() {
+		if t.Failed() {
+			t.Log(fn())
+		}
+	})
+}
+
+// Equals checks that var1 is deeply equal to var2, as determined by
+// reflect.DeepEqual, so slices, maps and structs containing them can be
+// compared. Functions are only equal when both are nil. Optionally, you can
+// pass an additional string and additional string formatters to be passed to
 // Test.Attest. If no message is specified, a message will be logged simply
 // stating that the two values weren't equal.
 func (t *Test) Equals(
 	var1, var2 interface{}, msgAndFormatters ...interface{},
 ) {
+	t.Helper()
 	if len(msgAndFormatters) > 0 {
 		t.Attest(
 			typeOf(var1) == typeOf(var2),
 			msgAndFormatters[0].(string),
 			msgAndFormatters[1:]...)
 		t.Attest(
-			var1 == var2,
+			reflect.DeepEqual(var1, var2),
 			msgAndFormatters[0].(string),
 			msgAndFormatters[1:]...)
 	} else {
@@ -186,98 +238,191 @@ func (t *Test) Equals(
 			var1,
 			var2,
 			var2)
+		equal := reflect.DeepEqual(var1, var2)
+		// the values are passed as formatters, rather than formatted into the
+		// message, so that a "%" in either isn't mistaken for a verb.
+		var differences string
+		if !equal && typeOf(var1) == typeOf(var2) && isComposite(var1) {
+			differences = "\nDifferences:\n" + t.Diff(var1, var2)
+		}
 		t.Attest(
-			var1 == var2,
-			fmt.Sprintf(
-				"Expected %#v (%v) was actually %#v (%v)",
-				var1,
-				var1,
-				var2,
-				var2))
+			equal,
+			"Expected %#v (%v) was actually %#v (%v)%s",
+			var1,
+			var1,
+			var2,
+			var2,
+			differences)
+	}
+}
+
+// isComposite reports whether value is a struct, slice, array, map or pointer,
+// whose differences are worth listing with Test.Diff.
+func isComposite(value interface{}) bool {
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map, reflect.Ptr:
+		return true
 	}
+	return false
+}
+
+// similar reports whether a and b are equal once formatted with
+// fmt.Sprintf("%v", ...). If both are slices or arrays, they're instead similar
+// when they have the same length and each pair of elements is similar. Two
+// floats are also similar if they're within DefaultEpsilon of one another.
+func similar(a, b interface{}) bool {
+	aValue, bValue := reflect.ValueOf(a), reflect.ValueOf(b)
+	if isFloat(aValue) && isFloat(bValue) &&
+		math.Abs(aValue.Float()-bValue.Float()) <= DefaultEpsilon {
+		return true
+	}
+	if isSequence(aValue) && isSequence(bValue) {
+		if aValue.Len() != bValue.Len() {
+			return false
+		}
+		for i := 0; i < aValue.Len(); i++ {
+			if !similar(aValue.Index(i).Interface(), bValue.Index(i).Interface()) {
+				return false
+			}
+		}
+		return true
+	}
+	return fmt.Sprintf("%v", a) == fmt.Sprintf("%v", b)
+}
+
+func isFloat(value reflect.Value) bool {
+	return value.Kind() == reflect.Float32 || value.Kind() == reflect.Float64
+}
+
+func isSequence(value reflect.Value) bool {
+	return value.Kind() == reflect.Slice || value.Kind() == reflect.Array
 }
 
 // Compares checks to see if var1 loosely equals var2. This allows for some
 // minor type coersion before checking equality. For example,
 // Test.Equals("5", 5) will fail, but Test.Compares("5", 5) will pass.
 //
-// This works by converting all values to a string with fmt.Sprintf("%v", value)
-// before checking equality.
+// This works by converting values to a string with fmt.Sprintf("%v", value)
+// before checking equality. Slices and arrays are compared element by element
+// in the same way, so []string{"5", "6"} is similar to []int{5, 6}, but
+// []string{"5 6"} isn't. Floats which differ only by rounding error, like
+// 0.1+0.2 and 0.3, are similar too; see DefaultEpsilon.
 func (t *Test) Compares(var1, var2 interface{}, msgAndFmt ...interface{}) {
-	t.Equals(fmt.Sprintf("%v", var1), fmt.Sprintf("%v", var2), msgAndFmt...)
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected %#v (%v) was actually %#v (%v)",
+			var1, var1, var2, var2,
+		}
+	}
+	t.Attest(similar(var1, var2), msgAndFmt[0].(string), msgAndFmt[1:]...)
 }
 
 // SimilarTo is a semantic mirror of "Compares".
 func (t *Test) SimilarTo(var1, var2 interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
 	t.Compares(var1, var2, msgAndFmt...)
 }
 
+// EqualsUnderlying is like Equals, but a value of a named type may equal a
+// value of its underlying type, so that EqualsUnderlying(MyInt(5), 5) passes.
+// Both values must be of the same kind, and one must be convertible to the
+// type of the other. This is looser than Equals, but stricter than Compares:
+// EqualsUnderlying(int64(5), 5) fails, as int64 and int are different kinds.
+func (t *Test) EqualsUnderlying(var1, var2 interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	value1, value2 := reflect.ValueOf(var1), reflect.ValueOf(var2)
+	if !value1.IsValid() || !value2.IsValid() ||
+		value1.Kind() != value2.Kind() ||
+		!value2.Type().ConvertibleTo(value1.Type()) {
+		t.errorf(
+			"%#v of type %T and %#v of type %T don't have the same underlying type, "+
+				"so they can't be compared.",
+			var1, var1, var2, var2)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected %#v (%v) was actually %#v (%v)",
+			var1, var1, var2, var2,
+		}
+	}
+	t.Attest(
+		reflect.DeepEqual(var1, value2.Convert(value1.Type()).Interface()),
+		msgAndFmt[0].(string),
+		msgAndFmt[1:]...)
+}
+
 // NotEqual fails the test if var1 equals var2, with the given message
-// and formatting.
+// and formatting. Like Equals, values are compared with reflect.DeepEqual, so
+// uncomparable values like maps, slices and functions can be passed.
 func (t *Test) NotEqual(var1, var2 interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
 	if typeOf(var1) != typeOf(var2) {
 		// types don't match, not equal by default.
+		t.assertions++
 		return
 	}
 	if len(msgAndFmt) == 0 {
-		t.NotEqual(
-			var1,
-			var2,
+		msgAndFmt = []interface{}{
 			"received equal values of %#+v, expected to not equal.",
 			var1,
-		)
+		}
 	}
-	t.Attest(var1 != var2, msgAndFmt[0].(string), msgAndFmt[1:]...)
+	t.AttestNot(reflect.DeepEqual(var1, var2), msgAndFmt[0].(string), msgAndFmt[1:]...)
 }
 
 // DoesNotCompare does the opposite of Compares/SimilarTo, the same as
 // NotSimilarTo
 func (t *Test) DoesNotCompare(var1, var2 interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
 	if len(msgAndFmt) == 0 {
-		t.DoesNotCompare(
-			var1,
-			var2,
-			"%#+v (%v as a string) was supposed to be similar to %#+v (string: %v)",
-			var1,
-			var1,
-			var2,
-			var2,
-		)
-	} else {
-		t.NotEqual(fmt.Sprintf("%v", var1), fmt.Sprintf("%v", var2), msgAndFmt...)
+		msgAndFmt = []interface{}{
+			"%#+v (%v as a string) was supposed to not be similar to %#+v (string: %v)",
+			var1, var1, var2, var2,
+		}
 	}
+	t.AttestNot(similar(var1, var2), msgAndFmt[0].(string), msgAndFmt[1:]...)
 }
 
 // NotSimilarTo does the opposite of Compares/SimilarTo, the same as
 // DoesNotCompare
 func (t *Test) NotSimilarTo(var1, var2 interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
 	t.DoesNotCompare(var1, var2, msgAndFmt...)
 }
 
-// Attest that `that` is true, or log `message` and fail the test.
+// Attest that `that` is true, or log `message` and fail the test. The message
+// is reported through testing.T, so it's attributed to the running test. It's
+// formatted with formatters, as by fmt.Sprintf; if there are none, it's
+// printed literally, so a message which happens to contain "%" is safe.
 func (t *Test) Attest(that bool, message string, formatters ...interface{}) {
+	t.Helper()
+	t.assertions++
 	if !that {
 		if len(formatters) == 0 {
-			fmt.Println(message)
+			t.errorf("%s", message)
 		} else {
-			fmt.Printf(message+"\n", formatters...)
+			t.errorf(message, formatters...)
 		}
-		t.fail()
 	}
 }
 
 // That mirrors the functionality of Attest.
 func (t *Test) That(boolean bool, message string, formatters ...interface{}) {
+	t.Helper()
 	t.Attest(boolean, message, formatters...)
 }
 
 // AttestNot -- assert that `that` is false. It just calls t.Attest(!that...
 func (t *Test) AttestNot(that bool, message string, formatters ...interface{}) {
+	t.Helper()
 	t.Attest(!that, message, formatters...)
 }
 
 // Not does exactly the same thing that AttestNot does.
 func (t *Test) Not(that bool, message string, formatters ...interface{}) {
+	t.Helper()
 	t.AttestNot(that, message, formatters...)
 }
 
@@ -287,14 +432,33 @@ func (t *Test) AttestOrDo(that bool,
 	callback func(*Test, ...interface{}),
 	cbArgs ...interface{},
 ) {
+	t.Helper()
+	t.assertions++
 	if !that {
 		callback(t, cbArgs...)
 		t.fail()
 	}
 }
 
-// Nil -- Log a message and fail if the variable is not nil
+// isNil reports whether value is nil, including a nil pointer, slice, map,
+// channel or function stored in a non-nil interface{}.
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func,
+		reflect.Interface, reflect.UnsafePointer:
+		return v.IsNil()
+	}
+	return false
+}
+
+// Nil -- Log a message and fail if the variable is not nil. Typed nils, like a
+// nil *int passed as an interface{}, are considered nil.
 func (t *Test) Nil(variable interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
 	var (
 		message string
 		format  []interface{}
@@ -310,7 +474,7 @@ func (t *Test) Nil(variable interface{}, msgAndFmt ...interface{}) {
 		format = msgAndFmt[1:]
 	}
 	t.Attest(
-		variable == nil,
+		isNil(variable),
 		message,
 		format...)
 }
@@ -318,118 +482,278 @@ func (t *Test) Nil(variable interface{}, msgAndFmt ...interface{}) {
 // NotNil --  Log a message and fail if the variable is nil. The explanatory
 // message is not optional for this function. If the explanatory message were
 // not provided, the default would be "nil was expected to not be nil" which
-// isn't very descriptive.
+// isn't very descriptive. Like Nil, typed nils are considered nil.
 func (t *Test) NotNil(variable interface{}, msg string, formatters ...interface{}) {
+	t.Helper()
 	t.Attest(
-		variable != nil,
+		!isNil(variable),
 		msg,
 		formatters...)
 }
 
+// isZero reports whether value is nil or the zero value of its type.
+func isZero(value interface{}) bool {
+	return value == nil || reflect.ValueOf(value).IsZero()
+}
+
+// Zero -- log a message and fail unless value is the zero value of its type.
+// This works uniformly for structs, numbers, strings, pointers and so on.
+func (t *Test) Zero(value interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"%#v was expected to be the zero value of %T",
+			value, value,
+		}
+	}
+	t.Attest(isZero(value), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// NotZero -- log a message and fail if value is the zero value of its type.
+func (t *Test) NotZero(value interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"%#v was expected to not be the zero value of %T",
+			value, value,
+		}
+	}
+	t.AttestNot(isZero(value), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// samePointers reports whether expected and actual are pointers of the same
+// type to the same object. ok is false if either isn't a pointer.
+func samePointers(expected, actual interface{}) (same, ok bool) {
+	expectedValue := reflect.ValueOf(expected)
+	actualValue := reflect.ValueOf(actual)
+	if expectedValue.Kind() != reflect.Ptr || actualValue.Kind() != reflect.Ptr {
+		return false, false
+	}
+	return expectedValue.Type() == actualValue.Type() &&
+		expectedValue.Pointer() == actualValue.Pointer(), true
+}
+
+// Same -- log a message and fail unless expected and actual are pointers to
+// the same object. Where Equals compares values, this checks identity.
+func (t *Test) Same(expected, actual interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	same, ok := samePointers(expected, actual)
+	if !ok {
+		t.errorf(
+			"Can't check that %#v and %#v are the same object: found non-pointer "+
+				"types %T and %T.",
+			expected, actual, expected, actual)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"%p (%#v) and %p (%#v) aren't the same object",
+			expected, expected, actual, actual,
+		}
+	}
+	t.Attest(same, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// NotSame is the inverse of Same.
+func (t *Test) NotSame(expected, actual interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	same, ok := samePointers(expected, actual)
+	if !ok {
+		t.errorf(
+			"Can't check that %#v and %#v aren't the same object: found "+
+				"non-pointer types %T and %T.",
+			expected, actual, expected, actual)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"%p (%#v) was expected to not be the same object as %p",
+			expected, expected, actual,
+		}
+	}
+	t.AttestNot(same, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
 // GreaterThan -- log a message and fail if the variable is less than the
-// expected value
+// expected value. Strings are compared lexicographically.
 func (t *Test) GreaterThan(
 	expected,
 	variable interface{},
 	msgAndFmt ...interface{},
 ) {
-	defaultMessage := fmt.Sprintf(
-		"Value (%#v) was less than expected (%#v).",
-		variable,
-		expected)
-	msg := func() string {
-		if len(msgAndFmt) == 0 {
-			return defaultMessage
-		}
-		if len(msgAndFmt) == 1 {
-			return msgAndFmt[0].(string)
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Value (%#v) was less than expected (%#v).",
+			variable,
+			expected,
 		}
-		return fmt.Sprintf(msgAndFmt[0].(string), msgAndFmt[1:]...)
 	}
+	msg, formatters := msgAndFmt[0].(string), msgAndFmt[1:]
 	switch variable.(type) {
 	default:
-		log.Printf(
+		t.errorf(
 			"When trying check that %v was greater than %v, found non-numeric "+
 				"types %T and %T.",
 			expected,
 			variable,
 			expected,
 			variable)
-		t.Fail()
 	case int:
-		t.Attest(variable.(int) > expected.(int), msg())
+		t.Attest(variable.(int) > expected.(int), msg, formatters...)
 	case int8:
-		t.Attest(variable.(int8) > expected.(int8), msg())
+		t.Attest(variable.(int8) > expected.(int8), msg, formatters...)
 	case int16:
-		t.Attest(variable.(int16) > expected.(int16), msg())
+		t.Attest(variable.(int16) > expected.(int16), msg, formatters...)
 	case int32:
-		t.Attest(variable.(int32) > expected.(int32), msg())
+		t.Attest(variable.(int32) > expected.(int32), msg, formatters...)
 	case int64:
-		t.Attest(variable.(int64) > expected.(int64), msg())
+		t.Attest(variable.(int64) > expected.(int64), msg, formatters...)
+	case uint:
+		t.Attest(variable.(uint) > expected.(uint), msg, formatters...)
+	case uint8:
+		t.Attest(variable.(uint8) > expected.(uint8), msg, formatters...)
+	case uint16:
+		t.Attest(variable.(uint16) > expected.(uint16), msg, formatters...)
+	case uint32:
+		t.Attest(variable.(uint32) > expected.(uint32), msg, formatters...)
+	case uint64:
+		t.Attest(variable.(uint64) > expected.(uint64), msg, formatters...)
+	case uintptr:
+		t.Attest(variable.(uintptr) > expected.(uintptr), msg, formatters...)
 	case float32:
-		t.Attest(variable.(float32) > expected.(float32), msg())
+		t.Attest(variable.(float32) > expected.(float32), msg, formatters...)
 	case float64:
-		t.Attest(variable.(float64) > expected.(float64), msg())
+		t.Attest(variable.(float64) > expected.(float64), msg, formatters...)
+	case string:
+		t.Attest(variable.(string) > expected.(string), msg, formatters...)
+	case complex64, complex128:
+		// can't use > on complex numbers because the set of complex numbers
+		// forms an unordered field.
+		t.errorf(
+			"Can't check value of %#v: complex numbers can't be ordered. Use "+
+				"GreaterMagnitude to compare their absolute values instead.",
+			variable)
 	}
-	// can't use > on complex numbers because the set of complex numbers forms an unordered field.
 }
 
-// LessThan -- log a message and fail if variable is negative.
+// LessThan -- log a message and fail if variable is greater than the expected
+// value. Strings are compared lexicographically.
 func (t *Test) LessThan(expected,
 	variable interface{},
 	msgAndFmt ...interface{},
 ) {
-	defaultMessage := fmt.Sprintf(
-		"Value (%#v) was greater than expected (%#v).",
-		variable,
-		expected)
-	msg := func() string {
-		if len(msgAndFmt) == 0 {
-			return defaultMessage
-		}
-		if len(msgAndFmt) == 1 {
-			return msgAndFmt[0].(string)
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Value (%#v) was greater than expected (%#v).",
+			variable,
+			expected,
 		}
-		return fmt.Sprintf(msgAndFmt[0].(string), msgAndFmt[1:]...)
 	}
+	msg, formatters := msgAndFmt[0].(string), msgAndFmt[1:]
 	switch variable.(type) {
 	default:
-		log.Printf(
+		t.errorf(
 			"Can't check value of %#v: check isn't implemented for type %T",
 			variable,
 			variable)
-		t.Fail()
 	case int:
-		t.Attest(variable.(int) < expected.(int), msg())
+		t.Attest(variable.(int) < expected.(int), msg, formatters...)
 	case int8:
-		t.Attest(variable.(int8) < expected.(int8), msg())
+		t.Attest(variable.(int8) < expected.(int8), msg, formatters...)
 	case int16:
-		t.Attest(variable.(int16) < expected.(int16), msg())
+		t.Attest(variable.(int16) < expected.(int16), msg, formatters...)
 	case int32:
-		t.Attest(variable.(int32) < expected.(int32), msg())
+		t.Attest(variable.(int32) < expected.(int32), msg, formatters...)
 	case int64:
-		t.Attest(variable.(int64) < expected.(int64), msg())
+		t.Attest(variable.(int64) < expected.(int64), msg, formatters...)
+	case uint:
+		t.Attest(variable.(uint) < expected.(uint), msg, formatters...)
+	case uint8:
+		t.Attest(variable.(uint8) < expected.(uint8), msg, formatters...)
+	case uint16:
+		t.Attest(variable.(uint16) < expected.(uint16), msg, formatters...)
+	case uint32:
+		t.Attest(variable.(uint32) < expected.(uint32), msg, formatters...)
+	case uint64:
+		t.Attest(variable.(uint64) < expected.(uint64), msg, formatters...)
+	case uintptr:
+		t.Attest(variable.(uintptr) < expected.(uintptr), msg, formatters...)
 	case float32:
-		t.Attest(variable.(float32) < expected.(float32), msg())
+		t.Attest(variable.(float32) < expected.(float32), msg, formatters...)
 	case float64:
-		t.Attest(variable.(float64) < expected.(float64), msg())
+		t.Attest(variable.(float64) < expected.(float64), msg, formatters...)
+	case string:
+		t.Attest(variable.(string) < expected.(string), msg, formatters...)
+	case complex64, complex128:
+		// can't use < on complex numbers because the set of complex numbers
+		// forms an unordered field.
+		t.errorf(
+			"Can't check value of %#v: complex numbers can't be ordered. Use "+
+				"LessMagnitude to compare their absolute values instead.",
+			variable)
+	}
+}
+
+// GreaterThanOrEqual -- like GreaterThan, but also passes if the variable is
+// equal to the expected value. Both values must be of the same numeric (or
+// string) type.
+func (t *Test) GreaterThanOrEqual(expected, variable interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	result, ok := compare(variable, expected)
+	if !ok {
+		t.errorf(
+			"Can't check that %#v is greater than or equal to %#v: types %T and %T "+
+				"can't be compared.",
+			variable, expected, variable, expected)
+		return
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Value (%#v) was less than expected (%#v).",
+			variable,
+			expected,
+		}
+	}
+	t.Attest(result >= 0, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// LessThanOrEqual -- like LessThan, but also passes if variable is equal to the
+// expected value. Both values must be of the same numeric (or string) type.
+func (t *Test) LessThanOrEqual(expected, variable interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	result, ok := compare(variable, expected)
+	if !ok {
+		t.errorf(
+			"Can't check that %#v is less than or equal to %#v: types %T and %T "+
+				"can't be compared.",
+			variable, expected, variable, expected)
+		return
 	}
-	// can't use > on complex numbers because the set of complex numbers forms an unordered field.
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Value (%#v) was greater than expected (%#v).",
+			variable,
+			expected,
+		}
+	}
+	t.Attest(result <= 0, msgAndFmt[0].(string), msgAndFmt[1:]...)
 }
 
 // Positive -- log a message and fail if variable is negative or zero.
 func (t *Test) Positive(variable interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
 	if len(msgAndFmt) == 0 {
 		msgAndFmt = []interface{}{"%#v was not positive", variable}
 	}
 	switch variable.(type) {
 	default:
-		log.Printf(
+		t.errorf(
 			"Can't check that %#v is positive: check isn't implemented for "+
 				"type %T",
 			variable,
 			variable)
-		t.fail()
 	case int:
 		t.Attest(
 			variable.(int) > 0,
@@ -471,17 +795,17 @@ func (t *Test) Positive(variable interface{}, msgAndFmt ...interface{}) {
 
 // Negative -- log a message and fail if variable is positive or zero.
 func (t *Test) Negative(variable interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
 	if len(msgAndFmt) == 0 {
-		msgAndFmt = []interface{}{"%#v was not positive", variable}
+		msgAndFmt = []interface{}{"%#v was not negative", variable}
 	}
 	switch variable.(type) {
 	default:
-		log.Printf(
+		t.errorf(
 			"Can't check that %#v is negative: check isn't implemented for "+
 				"type %T",
 			variable,
 			variable)
-		t.fail()
 	case int:
 		t.Attest(
 			variable.(int) < 0,
@@ -525,6 +849,8 @@ func (t *Test) Negative(variable interface{}, msgAndFmt ...interface{}) {
 // as determined by fmt.Sprintf("%T"). For example, a "Test" struct from the
 // "attest" package (this one), would have the type "attest.Test".
 func (t *Test) TypeIs(typestring string, value interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	t.assertions++
 	var message string
 	var formatters []interface{}
 	if len(msgAndFmt) == 0 {
@@ -544,6 +870,8 @@ func (t *Test) TypeIs(typestring string, value interface{}, msgAndFmt ...interfa
 // TypeIsNot is the inverse of TypeIs; it fails the test if the type of value
 // matches the typestring.
 func (t *Test) TypeIsNot(typestring string, value interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	t.assertions++
 	var message string
 	var formatters []interface{}
 	if len(msgAndFmt) == 0 {
@@ -560,8 +888,51 @@ func (t *Test) TypeIsNot(typestring string, value interface{}, msgAndFmt ...inte
 	}
 }
 
+// TypeIsLike fails the test unless value is of the same type as sample. This
+// avoids spelling out the type as a string for TypeIs; for example,
+// test.TypeIsLike("", value) checks that value is a string.
+func (t *Test) TypeIsLike(sample, value interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"Type of %#v was %T, expected %T.", value, value, sample}
+	}
+	t.Attest(
+		reflect.TypeOf(value) == reflect.TypeOf(sample),
+		msgAndFmt[0].(string),
+		msgAndFmt[1:]...)
+}
+
+// Implements fails the test unless value implements the interface which
+// interfacePtr points to. interfacePtr should be a nil pointer to the
+// interface type, for example:
+//
+//	test.Implements((*io.Writer)(nil), new(bytes.Buffer))
+func (t *Test) Implements(interfacePtr, value interface{}, msgAndFmt ...interface{}) {
+	t.Helper()
+	pointerType := reflect.TypeOf(interfacePtr)
+	if pointerType == nil ||
+		pointerType.Kind() != reflect.Ptr ||
+		pointerType.Elem().Kind() != reflect.Interface {
+		t.errorf(
+			"Implements: expected a pointer to an interface type like "+
+				"(*io.Reader)(nil), got %T",
+			interfacePtr)
+		return
+	}
+	interfaceType := pointerType.Elem()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{"%T doesn't implement %s", value, interfaceType}
+	}
+	valueType := reflect.TypeOf(value)
+	t.Attest(
+		valueType != nil && valueType.Implements(interfaceType),
+		msgAndFmt[0].(string),
+		msgAndFmt[1:]...)
+}
+
 // Matches determines if value matches the regex pattern
 func (t *Test) Matches(pattern *regexp.Regexp, value string, msgAndFmt ...interface{}) {
+	t.Helper()
 	matched := pattern.MatchString(value)
 	if len(msgAndFmt) == 0 {
 		t.Attest(matched, "string %v didn't match pattern %v", value, pattern)
@@ -572,6 +943,7 @@ func (t *Test) Matches(pattern *regexp.Regexp, value string, msgAndFmt ...interf
 
 // DoesNotMatch inverts Matches
 func (t *Test) DoesNotMatch(pattern *regexp.Regexp, value string, msgAndFmt ...interface{}) {
+	t.Helper()
 	matched := pattern.MatchString(value)
 	if len(msgAndFmt) == 0 {
 		t.AttestNot(
@@ -583,3 +955,64 @@ func (t *Test) DoesNotMatch(pattern *regexp.Regexp, value string, msgAndFmt ...i
 		t.AttestNot(matched, msgAndFmt[0].(string), msgAndFmt[1:]...)
 	}
 }
+
+// Regexp compiles pattern, failing the test immediately if it isn't a valid
+// regular expression. The compiled expression is returned, ready to be passed
+// to Matches or DoesNotMatch:
+//
+//	test.Matches(test.Regexp(`^\d+$`), value)
+func (t *Test) Regexp(pattern string) *regexp.Regexp {
+	t.Helper()
+	t.assertions++
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		t.Fatalf("Invalid pattern %q: %v", pattern, err)
+	}
+	return compiled
+}
+
+// MatchesString is like Matches, but compiles the pattern from a string. The
+// test fails if the pattern isn't a valid regular expression.
+func (t *Test) MatchesString(pattern, value string, msgAndFmt ...interface{}) {
+	t.Helper()
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		t.errorf("Invalid pattern %q: %v", pattern, err)
+		return
+	}
+	t.Matches(compiled, value, msgAndFmt...)
+}
+
+// DoesNotMatchString inverts MatchesString
+func (t *Test) DoesNotMatchString(pattern, value string, msgAndFmt ...interface{}) {
+	t.Helper()
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		t.errorf("Invalid pattern %q: %v", pattern, err)
+		return
+	}
+	t.DoesNotMatch(compiled, value, msgAndFmt...)
+}
+
+// RegexCaptures matches pattern against value, failing the test if it doesn't
+// match, and returns the text captured by each of the pattern's named groups,
+// keyed by name. Unnamed groups are ignored. If there's no match, the map is
+// empty.
+//
+//	fields := test.RegexCaptures(test.Regexp(`^(?P<level>\w+): (?P<msg>.*)$`), line)
+//	test.Equals("ERROR", fields["level"])
+func (t *Test) RegexCaptures(pattern *regexp.Regexp, value string) map[string]string {
+	t.Helper()
+	captures := make(map[string]string)
+	match := pattern.FindStringSubmatch(value)
+	t.Attest(match != nil, "Value %q didn't match pattern %v", value, pattern)
+	if match == nil {
+		return captures
+	}
+	for i, name := range pattern.SubexpNames() {
+		if i > 0 && name != "" {
+			captures[name] = match[i]
+		}
+	}
+	return captures
+}
@@ -0,0 +1,167 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import "time"
+
+// Never polls condition every interval for duration, and fails the test if it
+// ever returns true. This is useful for asserting that a goroutine does not
+// perform some action over a window of time.
+func (t *Test) Never(
+	condition func() bool,
+	duration, interval time.Duration,
+	msgAndFmt ...interface{},
+) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Condition became true within %v, expected it never to",
+			duration,
+		}
+	}
+	deadline := time.NewTimer(duration)
+	defer deadline.Stop()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if condition() {
+			t.Attest(false, msgAndFmt[0].(string), msgAndFmt[1:]...)
+			return
+		}
+		select {
+		case <-deadline.C:
+			t.assertions++
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// WithinDuration fails the test unless actual is within delta of expected, in
+// either direction.
+func (t *Test) WithinDuration(
+	expected, actual time.Time,
+	delta time.Duration,
+	msgAndFmt ...interface{},
+) {
+	t.Helper()
+	difference := actual.Sub(expected)
+	if difference < 0 {
+		difference = -difference
+	}
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"%v was %v from %v, more than the allowed %v",
+			actual, difference, expected, delta,
+		}
+	}
+	t.Attest(difference <= delta, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// Before fails the test unless earlier is before later.
+func (t *Test) Before(earlier, later time.Time, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"%s was expected to be before %s",
+			earlier.Format(time.RFC3339Nano),
+			later.Format(time.RFC3339Nano),
+		}
+	}
+	t.Attest(earlier.Before(later), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// After fails the test unless later is after earlier.
+func (t *Test) After(later, earlier time.Time, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"%s was expected to be after %s",
+			later.Format(time.RFC3339Nano),
+			earlier.Format(time.RFC3339Nano),
+		}
+	}
+	t.Attest(later.After(earlier), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// DurationLessThan fails the test unless actual is shorter than limit, e.g.
+// to check that an operation stayed within a budget:
+//
+//	test.DurationLessThan(100*time.Millisecond, elapsed)
+func (t *Test) DurationLessThan(limit, actual time.Duration, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"%s was expected to be less than %s",
+			actual.String(), limit.String(),
+		}
+	}
+	t.Attest(actual < limit, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// DurationGreaterThan fails the test unless actual is longer than limit.
+func (t *Test) DurationGreaterThan(limit, actual time.Duration, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"%s was expected to be greater than %s",
+			actual.String(), limit.String(),
+		}
+	}
+	t.Attest(actual > limit, msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
+
+// MeasureUnder calls fn, and fails the test if it took longer than budget to
+// return. The time it took is returned either way.
+func (t *Test) MeasureUnder(budget time.Duration, fn func()) time.Duration {
+	t.Helper()
+	start := time.Now()
+	fn()
+	elapsed := time.Since(start)
+	t.Attest(
+		elapsed <= budget,
+		"Function took %s, more than its budget of %s",
+		elapsed.String(), budget.String())
+	return elapsed
+}
+
+// Deadline fails the test if it hasn't finished within d, as a safety net for
+// tests which might hang. The failure is reported from another goroutine, so
+// it can't stop the test: a hung test keeps running until go test's -timeout
+// expires, but the deadline's failure shows why. The timer is stopped when
+// the test finishes; if it has already fired, the test waits for the failure
+// to be reported before finishing. The failure is always reported
+// immediately, even from a SoftTest.
+func (t *Test) Deadline(d time.Duration) {
+	t.Helper()
+	reported := make(chan struct{})
+	timer := time.AfterFunc(d, func() {
+		defer close(reported)
+		t.Errorf("Test didn't finish within its deadline of %s", d.String())
+	})
+	t.Cleanup(func() {
+		if !timer.Stop() {
+			<-reported
+		}
+	})
+}
+
+// TimeEqual fails the test unless expected and actual are the same instant,
+// as determined by time.Time.Equal. Unlike Equals, which compares every field
+// of the two times, this ignores their locations and monotonic clock
+// readings, so 12:00 UTC equals 14:00 in a +02:00 zone.
+func (t *Test) TimeEqual(expected, actual time.Time, msgAndFmt ...interface{}) {
+	t.Helper()
+	if len(msgAndFmt) == 0 {
+		msgAndFmt = []interface{}{
+			"Expected %s was actually %s",
+			expected.Format(time.RFC3339Nano),
+			actual.Format(time.RFC3339Nano),
+		}
+	}
+	t.Attest(expected.Equal(actual), msgAndFmt[0].(string), msgAndFmt[1:]...)
+}
@@ -0,0 +1,127 @@
+/**
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package attest
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNever(t *testing.T) {
+	test := New(t)
+	test.Never(func() bool { return false }, 20*time.Millisecond, time.Millisecond)
+	test.Attest(
+		fails(func(test *Test) {
+			var flipped int32
+			time.AfterFunc(10*time.Millisecond, func() { atomic.StoreInt32(&flipped, 1) })
+			test.Never(
+				func() bool { return atomic.LoadInt32(&flipped) == 1 },
+				time.Second,
+				time.Millisecond)
+		}),
+		"Never passed for a condition which became true")
+}
+
+func TestWithinDuration(t *testing.T) {
+	test := New(t)
+	now := time.Now()
+	test.WithinDuration(now, now, 0)
+	test.WithinDuration(now, now.Add(time.Second), time.Second)
+	test.WithinDuration(now, now.Add(-500*time.Millisecond), time.Second)
+	test.Attest(
+		fails(func(test *Test) {
+			test.WithinDuration(now, now.Add(time.Second+time.Nanosecond), time.Second)
+		}),
+		"WithinDuration passed for a time just outside delta")
+}
+
+func TestBefore(t *testing.T) {
+	test := New(t)
+	now := time.Now()
+	test.Before(now, now.Add(time.Nanosecond))
+	test.Attest(
+		fails(func(test *Test) { test.Before(now.Add(time.Hour), now) }),
+		"Before passed for reversed times")
+	test.Attest(
+		fails(func(test *Test) { test.Before(now, now) }),
+		"Before passed for equal times")
+}
+
+func TestAfter(t *testing.T) {
+	test := New(t)
+	now := time.Now()
+	test.After(now.Add(time.Nanosecond), now)
+	test.Attest(
+		fails(func(test *Test) { test.After(now, now.Add(time.Hour)) }),
+		"After passed for reversed times")
+	test.Attest(
+		fails(func(test *Test) { test.After(now, now) }),
+		"After passed for equal times")
+}
+
+func TestDurationLessThan(t *testing.T) {
+	test := New(t)
+	test.DurationLessThan(100*time.Millisecond, 99*time.Millisecond)
+	test.Attest(
+		fails(func(test *Test) { test.DurationLessThan(time.Second, time.Minute) }),
+		"DurationLessThan passed for a duration over the limit")
+	test.Attest(
+		fails(func(test *Test) { test.DurationLessThan(time.Second, time.Second) }),
+		"DurationLessThan passed for equal durations")
+}
+
+func TestDurationGreaterThan(t *testing.T) {
+	test := New(t)
+	test.DurationGreaterThan(time.Second, time.Minute)
+	test.Attest(
+		fails(func(test *Test) { test.DurationGreaterThan(time.Minute, time.Second) }),
+		"DurationGreaterThan passed for a duration under the limit")
+	test.Attest(
+		fails(func(test *Test) { test.DurationGreaterThan(time.Second, time.Second) }),
+		"DurationGreaterThan passed for equal durations")
+}
+
+func TestMeasureUnder(t *testing.T) {
+	test := New(t)
+	elapsed := test.MeasureUnder(time.Second, func() {})
+	test.DurationLessThan(time.Second, elapsed)
+	test.Attest(
+		fails(func(test *Test) {
+			test.MeasureUnder(time.Millisecond, func() { time.Sleep(20 * time.Millisecond) })
+		}),
+		"MeasureUnder passed for a function over budget")
+}
+
+func TestDeadline(t *testing.T) {
+	t.Run("fast", func(t *testing.T) {
+		test := New(t)
+		test.Deadline(time.Second)
+		test.Equals(2, 1+1)
+	})
+	output := failureOutput(t, func(test *Test) {
+		test.Deadline(10 * time.Millisecond)
+		time.Sleep(100 * time.Millisecond)
+	})
+	if !strings.Contains(output, "didn't finish within its deadline of 10ms") {
+		t.Errorf("expected the deadline to fail the test, got:\n%s", output)
+	}
+}
+
+func TestTimeEqual(t *testing.T) {
+	test := New(t)
+	utc := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	plusTwo := utc.In(time.FixedZone("+02:00", 2*60*60))
+	test.TimeEqual(utc, plusTwo)
+	test.Attest(
+		fails(func(test *Test) { test.Equals(utc, plusTwo) }),
+		"Equals passed for times in different locations")
+	test.Attest(
+		fails(func(test *Test) { test.TimeEqual(utc, utc.Add(time.Nanosecond)) }),
+		"TimeEqual passed for different instants")
+}